@@ -0,0 +1,96 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=envoy-gateway,shortName=ep
+// +kubebuilder:subresource:status
+
+// EnvoyProxy is the Schema for the envoyproxies API.
+type EnvoyProxy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EnvoyProxySpec   `json:"spec,omitempty"`
+	Status EnvoyProxyStatus `json:"status,omitempty"`
+}
+
+// EnvoyProxySpec defines the desired state of EnvoyProxy.
+type EnvoyProxySpec struct {
+	// Provider defines the desired resource provider and provider-specific
+	// configuration. If unspecified, the "Kubernetes" resource provider is used
+	// with default configuration parameters.
+	//
+	// +optional
+	Provider *EnvoyProxyProvider `json:"provider,omitempty"`
+	// Telemetry defines the desired telemetry configuration for managed Envoy
+	// proxies.
+	//
+	// +optional
+	Telemetry *ProxyTelemetry `json:"telemetry,omitempty"`
+}
+
+// ProxyTelemetry defines the desired telemetry configuration for managed
+// Envoy proxies.
+type ProxyTelemetry struct {
+	// Metrics defines the desired metrics-related telemetry configuration for
+	// managed Envoy proxies.
+	//
+	// +optional
+	Metrics *ProxyMetrics `json:"metrics,omitempty"`
+}
+
+// ProxyMetrics defines the desired metrics-related telemetry configuration
+// for managed Envoy proxies.
+type ProxyMetrics struct {
+	// PrometheusOperator configures scraping of the Envoy admin metrics
+	// endpoint through a Prometheus Operator ServiceMonitor, or a PodMonitor
+	// when the proxy is configured to run as a DaemonSet. Disabled by default,
+	// and skipped if the Prometheus Operator CRDs aren't installed in the
+	// cluster.
+	//
+	// +optional
+	PrometheusOperator *ProxyPrometheusOperatorMetricSink `json:"prometheusOperator,omitempty"`
+}
+
+// ProxyPrometheusOperatorMetricSink defines the desired state of the
+// Prometheus Operator ServiceMonitor/PodMonitor resource used to scrape the
+// Envoy admin metrics endpoint.
+type ProxyPrometheusOperatorMetricSink struct {
+	// Interval is the interval at which metrics should be scraped. Defaults to
+	// 30s.
+	//
+	// +optional
+	// +kubebuilder:default="30s"
+	Interval *metav1.Duration `json:"interval,omitempty"`
+	// Labels are additional labels to add to the generated
+	// ServiceMonitor/PodMonitor, useful for matching a Prometheus Operator
+	// PodMonitorSelector/ServiceMonitorSelector.
+	//
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// EnvoyProxyStatus defines the observed state of EnvoyProxy.
+type EnvoyProxyStatus struct {
+	// Conditions describe the current conditions of the EnvoyProxy resource.
+	//
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EnvoyProxyList contains a list of EnvoyProxy resources.
+type EnvoyProxyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EnvoyProxy `json:"items"`
+}
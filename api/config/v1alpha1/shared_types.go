@@ -0,0 +1,252 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// ProviderType defines the types of providers supported by Envoy Gateway.
+type ProviderType string
+
+const (
+	// ProviderTypeKubernetes defines the "Kubernetes" provider.
+	ProviderTypeKubernetes ProviderType = "Kubernetes"
+)
+
+// EnvoyProxyProvider defines the desired state of the Provider.
+type EnvoyProxyProvider struct {
+	// Type is the type of resource provider to use. A resource provider provides
+	// infrastructure resources for running the data plane, e.g. Envoy proxy, and
+	// optional auxiliary infrastructure. Supported types are "Kubernetes".
+	//
+	// +unionDiscriminator
+	Type ProviderType `json:"type"`
+	// Kubernetes defines the desired state of the Kubernetes resource provider.
+	// Kubernetes provides infrastructure resources for running the data plane,
+	// e.g. Envoy proxy. If unspecified and type is "Kubernetes", default settings
+	// for managed Kubernetes resources are applied.
+	//
+	// +optional
+	Kubernetes *EnvoyProxyKubernetesProvider `json:"kubernetes,omitempty"`
+}
+
+// EnvoyProxyKubernetesProvider defines configuration for the Kubernetes resource
+// provider.
+type EnvoyProxyKubernetesProvider struct {
+	// EnvoyDeployment defines the desired state of the Envoy deployment resource.
+	// If unspecified, default settings for the managed Envoy deployment resource
+	// are applied.
+	//
+	// +optional
+	EnvoyDeployment *KubernetesDeploymentSpec `json:"envoyDeployment,omitempty"`
+	// EnvoyDaemonSet defines the desired state of the Envoy daemonset resource.
+	// Disabled by default, a deployment resource is used instead to provision the
+	// Envoy proxy fleet.
+	//
+	// +optional
+	EnvoyDaemonSet *KubernetesDaemonSetSpec `json:"envoyDaemonSet,omitempty"`
+	// EnvoyStatefulSet defines the desired state of the Envoy statefulset
+	// resource. Disabled by default, a deployment resource is used instead to
+	// provision the Envoy proxy fleet. Useful when the proxy needs a stable
+	// network identity or persistent per-instance storage, e.g. for TLS session
+	// caches, rate-limit state, or SPIFFE identities tied to a specific ordinal.
+	//
+	// +optional
+	EnvoyStatefulSet *KubernetesStatefulSetSpec `json:"envoyStatefulSet,omitempty"`
+}
+
+// KubernetesDeploymentSpec defines the desired state of the Kubernetes deployment
+// resource.
+type KubernetesDeploymentSpec struct {
+	// Replicas is the number of desired pods. Defaults to 1.
+	//
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Pod defines the desired pod-level configuration of the Envoy proxy pods.
+	//
+	// +optional
+	Pod *KubernetesPodSpec `json:"pod,omitempty"`
+	// Container defines the desired configuration of the Envoy proxy container.
+	//
+	// +optional
+	Container *KubernetesContainerSpec `json:"container,omitempty"`
+	// UpgradeStrategy defines how the Deployment should be rolled over to a new
+	// Envoy proxy image. If unspecified, Kubernetes' own RollingUpdate strategy
+	// on the Deployment is used.
+	//
+	// +optional
+	UpgradeStrategy *KubernetesUpgradeStrategy `json:"upgradeStrategy,omitempty"`
+	// HorizontalPodAutoscaler defines the desired state of the Kubernetes
+	// horizontal pod autoscaler resource. Disabled by default, Replicas is used
+	// to control the number of Envoy proxy pods instead.
+	//
+	// +optional
+	HorizontalPodAutoscaler *KubernetesHorizontalPodAutoscalerSpec `json:"horizontalPodAutoscaler,omitempty"`
+	// PodDisruptionBudget defines the desired state of the Kubernetes pod
+	// disruption budget resource.
+	//
+	// +optional
+	PodDisruptionBudget *KubernetesPodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+}
+
+// KubernetesUpgradeStrategyType defines the types of image upgrade strategies
+// supported for the Envoy proxy Deployment.
+type KubernetesUpgradeStrategyType string
+
+const (
+	// UpgradeStrategyTypeRollingUpdate rolls the Deployment over to the new
+	// image directly, relying on Kubernetes' built-in RollingUpdate strategy.
+	UpgradeStrategyTypeRollingUpdate KubernetesUpgradeStrategyType = "RollingUpdate"
+	// UpgradeStrategyTypeCanary rolls a percentage of traffic over to a
+	// separate canary Deployment running the new image, bakes it for a
+	// configured duration, then promotes it to the primary Deployment.
+	UpgradeStrategyTypeCanary KubernetesUpgradeStrategyType = "Canary"
+)
+
+// KubernetesUpgradeStrategy defines the desired upgrade strategy for the
+// Envoy proxy Deployment.
+type KubernetesUpgradeStrategy struct {
+	// Type is the type of upgrade strategy to use. Supported types are
+	// "RollingUpdate" and "Canary".
+	//
+	// +unionDiscriminator
+	// +kubebuilder:default=RollingUpdate
+	Type KubernetesUpgradeStrategyType `json:"type,omitempty"`
+	// Canary defines the canary upgrade parameters. Required when Type is
+	// "Canary".
+	//
+	// +optional
+	Canary *CanaryUpgradeStrategy `json:"canary,omitempty"`
+}
+
+// CanaryUpgradeStrategy defines the desired canary image upgrade parameters.
+type CanaryUpgradeStrategy struct {
+	// Percentage of replicas, rounded up, that the canary Deployment should be
+	// scaled to while baking the new image. Defaults to 20.
+	//
+	// +optional
+	// +kubebuilder:default=20
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	Percentage *int32 `json:"percentage,omitempty"`
+	// BakeTime is the minimum duration the canary Deployment must be ready
+	// before it is promoted to the primary Deployment. Defaults to 5m.
+	//
+	// +optional
+	// +kubebuilder:default="5m"
+	BakeTime *metav1.Duration `json:"bakeTime,omitempty"`
+}
+
+// KubernetesDaemonSetSpec defines the desired state of the Kubernetes daemonset
+// resource.
+type KubernetesDaemonSetSpec struct {
+	// Pod defines the desired pod-level configuration of the Envoy proxy pods.
+	//
+	// +optional
+	Pod *KubernetesPodSpec `json:"pod,omitempty"`
+	// Container defines the desired configuration of the Envoy proxy container.
+	//
+	// +optional
+	Container *KubernetesContainerSpec `json:"container,omitempty"`
+}
+
+// KubernetesStatefulSetSpec defines the desired state of the Kubernetes
+// statefulset resource.
+type KubernetesStatefulSetSpec struct {
+	// Replicas is the number of desired pods. Defaults to 1.
+	//
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Pod defines the desired pod-level configuration of the Envoy proxy pods.
+	//
+	// +optional
+	Pod *KubernetesPodSpec `json:"pod,omitempty"`
+	// Container defines the desired configuration of the Envoy proxy container.
+	//
+	// +optional
+	Container *KubernetesContainerSpec `json:"container,omitempty"`
+	// VolumeClaimTemplates describes the PersistentVolumeClaims the StatefulSet
+	// controller provisions for each pod, giving each Envoy proxy instance its
+	// own persistent volume tied to its ordinal. The claimed volumes are not
+	// mounted into the Envoy proxy container.
+	//
+	// +optional
+	VolumeClaimTemplates []corev1.PersistentVolumeClaim `json:"volumeClaimTemplates,omitempty"`
+	// HorizontalPodAutoscaler defines the desired state of the Kubernetes
+	// horizontal pod autoscaler resource. Disabled by default, Replicas is used
+	// to control the number of Envoy proxy pods instead.
+	//
+	// +optional
+	HorizontalPodAutoscaler *KubernetesHorizontalPodAutoscalerSpec `json:"horizontalPodAutoscaler,omitempty"`
+	// PodDisruptionBudget defines the desired state of the Kubernetes pod
+	// disruption budget resource.
+	//
+	// +optional
+	PodDisruptionBudget *KubernetesPodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+}
+
+// KubernetesHorizontalPodAutoscalerSpec defines the desired state of the
+// Kubernetes horizontal pod autoscaler resource.
+type KubernetesHorizontalPodAutoscalerSpec struct {
+	// MinReplicas is the lower limit for the number of replicas the autoscaler
+	// can scale down to. Defaults to 1.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas is the upper limit for the number of replicas the autoscaler
+	// can scale up to.
+	//
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+	// Metrics contains the specifications used to calculate the desired
+	// replica count. If left empty, a default autoscaling policy of 80% CPU
+	// utilization is used.
+	//
+	// +optional
+	Metrics []autoscalingv2.MetricSpec `json:"metrics,omitempty"`
+}
+
+// KubernetesPodDisruptionBudgetSpec defines the desired state of the
+// Kubernetes pod disruption budget resource.
+type KubernetesPodDisruptionBudgetSpec struct {
+	// MinAvailable is the minimum number of pods, or percentage of pods
+	// expressed as "N%", that must remain available after an eviction.
+	//
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+}
+
+// KubernetesPodSpec defines the desired state of the Kubernetes pod resource.
+type KubernetesPodSpec struct {
+	// Annotations are the annotations that should be appended to the pods.
+	//
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Labels are the additional labels that should be tagged to the pods.
+	//
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// KubernetesContainerSpec defines the desired state of the Kubernetes container
+// resource.
+type KubernetesContainerSpec struct {
+	// Image specifies the EnvoyProxy container image to be used, instead of the
+	// default image.
+	//
+	// +optional
+	Image *string `json:"image,omitempty"`
+	// Resources required by this container. More info:
+	// https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+	//
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
@@ -0,0 +1,26 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package config
+
+const (
+	// DefaultNamespace is the default namespace that Envoy Gateway manages
+	// infra resources in.
+	DefaultNamespace = "envoy-gateway-system"
+)
+
+// Config is the configuration used by Envoy Gateway controllers.
+type Config struct {
+	// Namespace is the namespace that Envoy Gateway runs in and manages infra
+	// resources in.
+	Namespace string
+}
+
+// New returns a new Config with default parameters.
+func New() (*Config, error) {
+	return &Config{
+		Namespace: DefaultNamespace,
+	}, nil
+}
@@ -0,0 +1,38 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package envoygateway
+
+import (
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntimeMust(clientgoscheme.AddToScheme(scheme))
+	utilruntimeMust(appsv1.AddToScheme(scheme))
+	utilruntimeMust(corev1.AddToScheme(scheme))
+	utilruntimeMust(egcfgv1a1.AddToScheme(scheme))
+	utilruntimeMust(monitoringv1.AddToScheme(scheme))
+}
+
+func utilruntimeMust(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+// GetScheme returns the runtime Scheme used by Envoy Gateway controllers,
+// with all known API types registered.
+func GetScheme() *runtime.Scheme {
+	return scheme
+}
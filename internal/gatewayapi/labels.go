@@ -0,0 +1,16 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package gatewayapi
+
+const (
+	// OwningGatewayNamespaceLabel is the owner reference label used for managed
+	// infra resources, referring to the namespace of the accepted Gateway
+	// resource.
+	OwningGatewayNamespaceLabel = "gateway.envoyproxy.io/owning-gateway-namespace"
+	// OwningGatewayNameLabel is the owner reference label used for managed infra
+	// resources, referring to the name of the accepted Gateway resource.
+	OwningGatewayNameLabel = "gateway.envoyproxy.io/owning-gateway-name"
+)
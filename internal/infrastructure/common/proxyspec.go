@@ -0,0 +1,81 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+// Package common defines the provider-agnostic building blocks shared by
+// Envoy Gateway's infrastructure.Provider implementations, so that backends
+// like Kubernetes and Docker translate the same intermediate representation
+// instead of each re-deriving it from ir.ProxyInfra independently.
+package common
+
+import (
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+const (
+	// defaultEnvoyImage is the image used to run the managed Envoy proxy
+	// fleet when none is specified.
+	defaultEnvoyImage = "envoyproxy/envoy:distroless-dev"
+)
+
+// ProxySpec is the generic, provider-agnostic description of the managed
+// Envoy proxy workload: the subset of ir.ProxyInfra that any backend needs
+// to run an Envoy proxy instance, whether that's a Kubernetes pod or a
+// Docker container. Providers translate a ProxySpec into their own
+// primitives rather than reading ir.ProxyInfra directly.
+type ProxySpec struct {
+	// Name is the name used for the managed proxy infra resources.
+	Name string
+	// Image is the container image to run the Envoy proxy with.
+	Image string
+	// Labels are the labels that should be applied to the managed proxy
+	// infra resources.
+	Labels map[string]string
+}
+
+// NewProxySpec returns the ProxySpec for the provided ProxyInfra.
+//
+// Image is taken from the Kubernetes provider's EnvoyDeployment container
+// image configuration, since EnvoyProxyProvider has no provider-agnostic field for
+// it yet. Non-Kubernetes providers that need to override the image should do
+// so once such a field is added.
+func NewProxySpec(infra *ir.ProxyInfra) *ProxySpec {
+	return &ProxySpec{
+		Name:   infra.Name,
+		Image:  expectedImage(infra.GetProxyConfig()),
+		Labels: expectedLabels(infra),
+	}
+}
+
+// expectedImage returns the Envoy proxy container image to use, falling back
+// to defaultEnvoyImage when unspecified.
+func expectedImage(config *egcfgv1a1.EnvoyProxy) string {
+	provider := config.Spec.Provider
+	if provider == nil || provider.Kubernetes == nil || provider.Kubernetes.EnvoyDeployment == nil {
+		return defaultEnvoyImage
+	}
+
+	container := provider.Kubernetes.EnvoyDeployment.Container
+	if container == nil || container.Image == nil {
+		return defaultEnvoyImage
+	}
+	return *container.Image
+}
+
+// expectedLabels returns the labels that should be applied to all managed
+// proxy infra resources.
+func expectedLabels(infra *ir.ProxyInfra) map[string]string {
+	labels := map[string]string{
+		"app.kubernetes.io/name":      "envoy",
+		"app.kubernetes.io/component": "proxy",
+		"app.kubernetes.io/instance":  infra.Name,
+	}
+	if infra.Metadata != nil {
+		for k, v := range infra.Metadata.Labels {
+			labels[k] = v
+		}
+	}
+	return labels
+}
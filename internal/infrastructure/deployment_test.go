@@ -0,0 +1,104 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package infrastructure_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/envoyproxy/gateway/internal/envoygateway"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/infrastructure"
+	"github.com/envoyproxy/gateway/internal/infrastructure/docker"
+	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes"
+	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/proxy"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// providerCase exercises the infrastructure.Provider contract against a
+// specific backend, confirming the managed proxy actually exists (or
+// doesn't, once deleted) in that backend's own terms. Kubernetes-only
+// behavior, such as DaemonSet/StatefulSet conversion, is covered by the
+// Kubernetes suite instead of here.
+type providerCase struct {
+	name     string
+	provider infrastructure.Provider
+	exists   func(name string) bool
+}
+
+// fakeDockerRunner is a docker.Runner that tracks running containers
+// in-memory instead of shelling out to a real docker daemon.
+type fakeDockerRunner struct {
+	images map[string]string
+}
+
+func (f *fakeDockerRunner) Run(_ context.Context, args ...string) (string, error) {
+	switch args[0] {
+	case "run":
+		// args: run -d --name <name> <image>
+		f.images[args[3]] = args[4]
+	case "inspect":
+		name := args[len(args)-1]
+		image, ok := f.images[name]
+		if !ok {
+			return "", fmt.Errorf("no such container: %s", name)
+		}
+		return image, nil
+	case "rm":
+		delete(f.images, args[len(args)-1])
+	}
+	return "", nil
+}
+
+func providerCases() []providerCase {
+	cfg, _ := config.New()
+	cli := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).Build()
+	kube := kubernetes.NewInfra(cli, cfg)
+
+	dockerRunner := &fakeDockerRunner{images: map[string]string{}}
+
+	return []providerCase{
+		{
+			name:     "kubernetes",
+			provider: kube,
+			exists: func(name string) bool {
+				key := client.ObjectKey{Namespace: cfg.Namespace, Name: proxy.ExpectedResourceHashedName(name)}
+				return cli.Get(context.Background(), key, &appsv1.Deployment{}) == nil
+			},
+		},
+		{
+			name:     "docker",
+			provider: &docker.Provider{Runner: dockerRunner},
+			exists: func(name string) bool {
+				_, ok := dockerRunner.images[name]
+				return ok
+			},
+		},
+	}
+}
+
+func TestCreateOrUpdateAndDeleteProxy(t *testing.T) {
+	for _, c := range providerCases() {
+		t.Run(c.name, func(t *testing.T) {
+			infra := ir.NewInfra()
+			infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNamespaceLabel] = "default"
+			infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNameLabel] = infra.Proxy.Name
+
+			require.NoError(t, c.provider.CreateOrUpdateProxy(context.Background(), infra))
+			require.True(t, c.exists(infra.Proxy.Name), "expected proxy to exist after create")
+
+			require.NoError(t, c.provider.DeleteProxy(context.Background(), infra))
+			require.False(t, c.exists(infra.Proxy.Name), "expected proxy to be gone after delete")
+		})
+	}
+}
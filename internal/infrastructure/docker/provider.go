@@ -0,0 +1,92 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+// Package docker implements the infrastructure.Provider for running Envoy
+// Gateway's managed infrastructure as local Docker containers, for local
+// development and edge deployments that have no Kubernetes cluster
+// available.
+package docker
+
+import (
+	"context"
+	"strings"
+
+	"github.com/envoyproxy/gateway/internal/infrastructure"
+	"github.com/envoyproxy/gateway/internal/infrastructure/common"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// Provider manages the creation and deletion of Docker containers for Envoy
+// Gateway's managed infrastructure.
+type Provider struct {
+	// Runner is used to invoke the docker CLI to manage containers.
+	Runner Runner
+}
+
+var _ infrastructure.Provider = (*Provider)(nil)
+
+// NewProvider returns a new Provider that manages containers via the docker
+// CLI.
+func NewProvider() *Provider {
+	return &Provider{Runner: execCommandRunner{}}
+}
+
+// CreateOrUpdateProxy creates or updates the Docker container running the
+// managed Envoy proxy fleet described by infra, satisfying the
+// infrastructure.Provider interface.
+func (p *Provider) CreateOrUpdateProxy(ctx context.Context, infra *ir.Infra) error {
+	spec := common.NewProxySpec(infra.GetProxyInfra())
+	return p.createOrUpdateContainer(ctx, spec.Name, spec.Image)
+}
+
+// DeleteProxy deletes the Docker container running the managed Envoy proxy
+// fleet described by infra, satisfying the infrastructure.Provider
+// interface.
+func (p *Provider) DeleteProxy(ctx context.Context, infra *ir.Infra) error {
+	spec := common.NewProxySpec(infra.GetProxyInfra())
+	return p.deleteContainer(ctx, spec.Name)
+}
+
+// CreateOrUpdateRateLimit creates or updates the Docker container running
+// the managed global rate limit service described by infra, satisfying the
+// infrastructure.Provider interface.
+func (p *Provider) CreateOrUpdateRateLimit(ctx context.Context, infra *ir.RateLimitInfra) error {
+	return p.createOrUpdateContainer(ctx, infra.Name, infra.Image)
+}
+
+// DeleteRateLimit deletes the Docker container running the managed global
+// rate limit service described by infra, satisfying the
+// infrastructure.Provider interface.
+func (p *Provider) DeleteRateLimit(ctx context.Context, infra *ir.RateLimitInfra) error {
+	return p.deleteContainer(ctx, infra.Name)
+}
+
+// createOrUpdateContainer ensures a container named name is running image,
+// replacing it if a container by that name is already running a different
+// image, and creating it if it doesn't exist yet.
+func (p *Provider) createOrUpdateContainer(ctx context.Context, name, image string) error {
+	current, err := p.Runner.Run(ctx, "inspect", "--format", "{{.Config.Image}}", name)
+	if err != nil {
+		// docker inspect fails if no container by that name exists yet.
+		_, err := p.Runner.Run(ctx, "run", "-d", "--name", name, image)
+		return err
+	}
+
+	if strings.TrimSpace(current) == image {
+		return nil
+	}
+
+	if err := p.deleteContainer(ctx, name); err != nil {
+		return err
+	}
+	_, err = p.Runner.Run(ctx, "run", "-d", "--name", name, image)
+	return err
+}
+
+// deleteContainer force-removes the container named name, if it exists.
+func (p *Provider) deleteContainer(ctx context.Context, name string) error {
+	_, err := p.Runner.Run(ctx, "rm", "-f", name)
+	return err
+}
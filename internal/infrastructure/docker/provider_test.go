@@ -0,0 +1,92 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// fakeRunner is a Runner that records invocations instead of
+// shelling out to a real docker CLI, and reports a container as existing
+// once a "run" for its name has been recorded.
+type fakeRunner struct {
+	calls  [][]string
+	images map[string]string
+}
+
+func newFakeRunner() *fakeRunner {
+	return &fakeRunner{images: map[string]string{}}
+}
+
+func (f *fakeRunner) Run(_ context.Context, args ...string) (string, error) {
+	f.calls = append(f.calls, args)
+
+	switch args[0] {
+	case "run":
+		// args: run -d --name <name> <image>
+		name, image := args[3], args[4]
+		f.images[name] = image
+		return "", nil
+	case "inspect":
+		name := args[len(args)-1]
+		image, ok := f.images[name]
+		if !ok {
+			return "", fmt.Errorf("no such container: %s", name)
+		}
+		return image, nil
+	case "rm":
+		name := args[len(args)-1]
+		delete(f.images, name)
+		return "", nil
+	}
+	return "", nil
+}
+
+func proxyInfra() *ir.Infra {
+	infra := ir.NewInfra()
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNamespaceLabel] = "default"
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNameLabel] = infra.Proxy.Name
+	return infra
+}
+
+func TestCreateOrUpdateProxy(t *testing.T) {
+	runner := newFakeRunner()
+	p := &Provider{Runner: runner}
+
+	infra := proxyInfra()
+	require.NoError(t, p.CreateOrUpdateProxy(context.Background(), infra))
+	require.Equal(t, "envoyproxy/envoy:distroless-dev", runner.images["default"])
+
+	// Calling again with the same image is a no-op; it shouldn't be
+	// recreated.
+	callsBefore := len(runner.calls)
+	require.NoError(t, p.CreateOrUpdateProxy(context.Background(), infra))
+	require.Equal(t, callsBefore+1, len(runner.calls), "expected only an inspect call")
+
+	require.NoError(t, p.DeleteProxy(context.Background(), infra))
+	_, ok := runner.images["default"]
+	require.False(t, ok)
+}
+
+func TestCreateOrUpdateRateLimit(t *testing.T) {
+	runner := newFakeRunner()
+	p := &Provider{Runner: runner}
+
+	infra := ir.NewRateLimitInfra("envoyproxy/ratelimit:latest")
+	require.NoError(t, p.CreateOrUpdateRateLimit(context.Background(), infra))
+	require.Equal(t, "envoyproxy/ratelimit:latest", runner.images[infra.Name])
+
+	require.NoError(t, p.DeleteRateLimit(context.Background(), infra))
+	_, ok := runner.images[infra.Name]
+	require.False(t, ok)
+}
@@ -0,0 +1,28 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package docker
+
+import (
+	"context"
+	"os/exec"
+)
+
+// Runner abstracts invoking the docker CLI, so Provider can be backed by a
+// fake in tests, or by an alternative executor (e.g. podman), instead of
+// always shelling out to a real docker daemon.
+type Runner interface {
+	// Run executes `docker <args...>`, returning its combined stdout/stderr.
+	Run(ctx context.Context, args ...string) (string, error)
+}
+
+// execCommandRunner runs the docker CLI via os/exec.
+type execCommandRunner struct{}
+
+// Run implements Runner.
+func (execCommandRunner) Run(ctx context.Context, args ...string) (string, error) {
+	out, err := exec.CommandContext(ctx, "docker", args...).CombinedOutput()
+	return string(out), err
+}
@@ -0,0 +1,116 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package kubernetes
+
+import (
+	"context"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/proxy"
+)
+
+const (
+	// canaryBakeStartedAnnotation records the RFC3339 timestamp at which the
+	// canary Deployment was first observed ready, so promotion can wait out the
+	// configured bake time across reconciles.
+	canaryBakeStartedAnnotation = "gateway.envoyproxy.io/canary-bake-started"
+)
+
+// reconcileCanaryDeployment rolls target's container image out via a canary
+// Deployment scaled to a percentage of current's replica count, promoting it
+// to current once it has been ready for the strategy's bake time. Rolling
+// current back to the image it's already running aborts any canary in
+// flight.
+func (i *Infra) reconcileCanaryDeployment(ctx context.Context, r *proxy.ResourceRender, current, target *appsv1.Deployment, strategy *egcfgv1a1.CanaryUpgradeStrategy) error {
+	targetImage := proxy.ContainerImage(target)
+	currentImage := proxy.ContainerImage(current)
+
+	if targetImage == currentImage {
+		// No image change in flight (or it was rolled back): make sure any
+		// leftover canary is gone and apply the rest of the spec directly.
+		if err := i.deleteCanaryDeployment(ctx, r); err != nil {
+			return err
+		}
+		current.Spec = target.Spec
+		delete(current.Annotations, canaryBakeStartedAnnotation)
+		return i.Client.Update(ctx, current)
+	}
+
+	canary := r.CanaryDeployment(current, targetImage, proxy.CanaryPercentage(strategy))
+
+	currentCanary := &appsv1.Deployment{}
+	err := i.Client.Get(ctx, client.ObjectKeyFromObject(canary), currentCanary)
+	switch {
+	case kerrors.IsNotFound(err):
+		return i.Client.Create(ctx, canary)
+	case err != nil:
+		return err
+	}
+
+	if !deploymentReady(currentCanary) {
+		currentCanary.Spec = canary.Spec
+		return i.Client.Update(ctx, currentCanary)
+	}
+
+	startedAt, baked := current.Annotations[canaryBakeStartedAnnotation]
+	if !baked {
+		return i.annotateCanaryBakeStarted(ctx, current)
+	}
+
+	started, err := time.Parse(time.RFC3339, startedAt)
+	if err != nil || time.Since(started) < proxy.CanaryBakeTime(strategy) {
+		// Bake time not yet elapsed (or the annotation was unparsable, in
+		// which case waiting for the next reconcile is the safe default).
+		return nil
+	}
+
+	// Promote: apply the target spec to the primary Deployment and remove the
+	// canary.
+	current.Spec = target.Spec
+	delete(current.Annotations, canaryBakeStartedAnnotation)
+	if err := i.Client.Update(ctx, current); err != nil {
+		return err
+	}
+	return i.deleteCanaryDeployment(ctx, r)
+}
+
+// annotateCanaryBakeStarted records that the canary Deployment has become
+// ready, starting the bake-time clock.
+func (i *Infra) annotateCanaryBakeStarted(ctx context.Context, current *appsv1.Deployment) error {
+	if current.Annotations == nil {
+		current.Annotations = map[string]string{}
+	}
+	current.Annotations[canaryBakeStartedAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	return i.Client.Update(ctx, current)
+}
+
+// deleteCanaryDeployment deletes the canary Deployment rendered for r, if it
+// exists.
+func (i *Infra) deleteCanaryDeployment(ctx context.Context, r *proxy.ResourceRender) error {
+	canary := &appsv1.Deployment{}
+	canary.Namespace = r.Namespace
+	canary.Name = r.CanaryName()
+
+	if err := i.Client.Delete(ctx, canary); err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// deploymentReady returns true once all of a Deployment's desired replicas
+// are reported ready.
+func deploymentReady(deploy *appsv1.Deployment) bool {
+	replicas := int32(1)
+	if deploy.Spec.Replicas != nil {
+		replicas = *deploy.Spec.Replicas
+	}
+	return deploy.Status.ReadyReplicas >= replicas
+}
@@ -0,0 +1,175 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/envoygateway"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/proxy"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// canaryProxyInfra returns an ir.Infra configured with a Canary
+// UpgradeStrategy scaling the canary Deployment to 100% of the primary's
+// replicas, so a single replica primary needs only a single ready canary
+// replica to bake.
+func canaryProxyInfra(image string) *ir.Infra {
+	infra := ir.NewInfra()
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNamespaceLabel] = "default"
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNameLabel] = infra.Proxy.Name
+	infra.Proxy.GetProxyConfig().Spec.Provider = &egcfgv1a1.EnvoyProxyProvider{
+		Type: egcfgv1a1.ProviderTypeKubernetes,
+		Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+			EnvoyDeployment: &egcfgv1a1.KubernetesDeploymentSpec{
+				Container: &egcfgv1a1.KubernetesContainerSpec{
+					Image: pointer.String(image),
+				},
+				UpgradeStrategy: &egcfgv1a1.KubernetesUpgradeStrategy{
+					Type: egcfgv1a1.UpgradeStrategyTypeCanary,
+					Canary: &egcfgv1a1.CanaryUpgradeStrategy{
+						Percentage: pointer.Int32(100),
+						BakeTime:   &metav1.Duration{Duration: time.Minute},
+					},
+				},
+			},
+		},
+	}
+	return infra
+}
+
+func TestCreateCanaryDeployment(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	oldInfra := canaryProxyInfra("envoyproxy/envoy:v1.0.0")
+	r := proxy.NewResourceRender(cfg.Namespace, oldInfra.GetProxyInfra())
+	primary, err := r.Deployment()
+	require.NoError(t, err)
+
+	cli := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).WithObjects(primary).Build()
+	kube := NewInfra(cli, cfg)
+
+	newInfra := canaryProxyInfra("envoyproxy/envoy:v1.1.0")
+	r = proxy.NewResourceRender(kube.Namespace, newInfra.GetProxyInfra())
+	require.NoError(t, kube.createOrUpdateDeployment(context.Background(), r))
+
+	canary := &appsv1.Deployment{}
+	require.NoError(t, kube.Client.Get(context.Background(), client.ObjectKey{Namespace: kube.Namespace, Name: r.CanaryName()}, canary))
+	require.Equal(t, "envoyproxy/envoy:v1.1.0", proxy.ContainerImage(canary))
+
+	// The primary Deployment keeps running the old image until the canary
+	// bakes successfully.
+	current := &appsv1.Deployment{}
+	require.NoError(t, kube.Client.Get(context.Background(), client.ObjectKeyFromObject(primary), current))
+	require.Equal(t, "envoyproxy/envoy:v1.0.0", proxy.ContainerImage(current))
+}
+
+func TestPromoteCanaryAfterBake(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	oldInfra := canaryProxyInfra("envoyproxy/envoy:v1.0.0")
+	r := proxy.NewResourceRender(cfg.Namespace, oldInfra.GetProxyInfra())
+	primary, err := r.Deployment()
+	require.NoError(t, err)
+	primary.Annotations = map[string]string{
+		canaryBakeStartedAnnotation: time.Now().Add(-10 * time.Minute).UTC().Format(time.RFC3339),
+	}
+
+	canary := r.CanaryDeployment(primary, "envoyproxy/envoy:v1.1.0", 100)
+	canary.Status.ReadyReplicas = 1
+
+	cli := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).WithObjects(primary, canary).Build()
+	kube := NewInfra(cli, cfg)
+
+	newInfra := canaryProxyInfra("envoyproxy/envoy:v1.1.0")
+	r = proxy.NewResourceRender(kube.Namespace, newInfra.GetProxyInfra())
+	require.NoError(t, kube.createOrUpdateDeployment(context.Background(), r))
+
+	current := &appsv1.Deployment{}
+	require.NoError(t, kube.Client.Get(context.Background(), client.ObjectKeyFromObject(primary), current))
+	require.Equal(t, "envoyproxy/envoy:v1.1.0", proxy.ContainerImage(current))
+	require.NotContains(t, current.Annotations, canaryBakeStartedAnnotation)
+
+	// The canary Deployment is removed once promoted.
+	err = kube.Client.Get(context.Background(), client.ObjectKey{Namespace: kube.Namespace, Name: r.CanaryName()}, &appsv1.Deployment{})
+	require.Error(t, err)
+}
+
+func TestAbortCanaryOnRollback(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	oldInfra := canaryProxyInfra("envoyproxy/envoy:v1.0.0")
+	r := proxy.NewResourceRender(cfg.Namespace, oldInfra.GetProxyInfra())
+	primary, err := r.Deployment()
+	require.NoError(t, err)
+	primary.Annotations = map[string]string{
+		canaryBakeStartedAnnotation: time.Now().UTC().Format(time.RFC3339),
+	}
+
+	canary := r.CanaryDeployment(primary, "envoyproxy/envoy:v1.1.0", 100)
+
+	cli := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).WithObjects(primary, canary).Build()
+	kube := NewInfra(cli, cfg)
+
+	// Reconfigure back to the image the primary Deployment is already
+	// running: the canary in flight should be aborted.
+	r = proxy.NewResourceRender(kube.Namespace, oldInfra.GetProxyInfra())
+	require.NoError(t, kube.createOrUpdateDeployment(context.Background(), r))
+
+	current := &appsv1.Deployment{}
+	require.NoError(t, kube.Client.Get(context.Background(), client.ObjectKeyFromObject(primary), current))
+	require.NotContains(t, current.Annotations, canaryBakeStartedAnnotation)
+
+	err = kube.Client.Get(context.Background(), client.ObjectKey{Namespace: kube.Namespace, Name: r.CanaryName()}, &appsv1.Deployment{})
+	require.Error(t, err)
+}
+
+func TestDeleteCanaryDeploymentOnWorkloadTypeChange(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	oldInfra := canaryProxyInfra("envoyproxy/envoy:v1.0.0")
+	r := proxy.NewResourceRender(cfg.Namespace, oldInfra.GetProxyInfra())
+	primary, err := r.Deployment()
+	require.NoError(t, err)
+	canary := r.CanaryDeployment(primary, "envoyproxy/envoy:v1.1.0", 100)
+
+	cli := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).WithObjects(primary, canary).Build()
+	kube := NewInfra(cli, cfg)
+
+	// Switching the proxy to a DaemonSet while a canary is in flight must
+	// clean up the orphaned canary Deployment rather than leaving it running
+	// forever.
+	daemonSetInfra := ir.NewInfra()
+	daemonSetInfra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNamespaceLabel] = "default"
+	daemonSetInfra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNameLabel] = daemonSetInfra.Proxy.Name
+	daemonSetInfra.Proxy.GetProxyConfig().Spec.Provider = &egcfgv1a1.EnvoyProxyProvider{
+		Type: egcfgv1a1.ProviderTypeKubernetes,
+		Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+			EnvoyDaemonSet: &egcfgv1a1.KubernetesDaemonSetSpec{},
+		},
+	}
+	r = proxy.NewResourceRender(kube.Namespace, daemonSetInfra.GetProxyInfra())
+	require.NoError(t, kube.createOrUpdatePodSet(context.Background(), r))
+
+	err = kube.Client.Get(context.Background(), client.ObjectKey{Namespace: kube.Namespace, Name: r.CanaryName()}, &appsv1.Deployment{})
+	require.Error(t, err)
+}
@@ -0,0 +1,54 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package kubernetes
+
+import (
+	"context"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/proxy"
+)
+
+// createOrUpdateHorizontalPodAutoscaler creates or updates the
+// HorizontalPodAutoscaler rendered by r, deleting it if r is no longer
+// configured with one.
+func (i *Infra) createOrUpdateHorizontalPodAutoscaler(ctx context.Context, r *proxy.ResourceRender) error {
+	hpa, err := r.HorizontalPodAutoscaler()
+	if err != nil {
+		return err
+	}
+	if hpa == nil {
+		return i.deleteHorizontalPodAutoscaler(ctx, r)
+	}
+
+	current := &autoscalingv2.HorizontalPodAutoscaler{}
+	key := client.ObjectKeyFromObject(hpa)
+	if err := i.Client.Get(ctx, key, current); err != nil {
+		if kerrors.IsNotFound(err) {
+			return i.Client.Create(ctx, hpa)
+		}
+		return err
+	}
+
+	current.Spec = hpa.Spec
+	return i.Client.Update(ctx, current)
+}
+
+// deleteHorizontalPodAutoscaler deletes the HorizontalPodAutoscaler rendered
+// by r, if it exists.
+func (i *Infra) deleteHorizontalPodAutoscaler(ctx context.Context, r *proxy.ResourceRender) error {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	hpa.Namespace = r.Namespace
+	hpa.Name = r.Name()
+
+	if err := i.Client.Delete(ctx, hpa); err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/envoygateway"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/proxy"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// hpaProxyInfra returns an ir.Infra whose Deployment is configured with a
+// HorizontalPodAutoscaler of the given bounds, or none if hpa is nil.
+func hpaProxyInfra(hpa *egcfgv1a1.KubernetesHorizontalPodAutoscalerSpec) *ir.Infra {
+	infra := ir.NewInfra()
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNamespaceLabel] = "default"
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNameLabel] = infra.Proxy.Name
+	infra.Proxy.GetProxyConfig().Spec.Provider = &egcfgv1a1.EnvoyProxyProvider{
+		Type: egcfgv1a1.ProviderTypeKubernetes,
+		Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+			EnvoyDeployment: &egcfgv1a1.KubernetesDeploymentSpec{
+				HorizontalPodAutoscaler: hpa,
+			},
+		},
+	}
+	return infra
+}
+
+func TestCreateOrUpdateHorizontalPodAutoscaler(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	cli := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).Build()
+	kube := NewInfra(cli, cfg)
+
+	infra := hpaProxyInfra(&egcfgv1a1.KubernetesHorizontalPodAutoscalerSpec{
+		MinReplicas: pointer.Int32(1),
+		MaxReplicas: 5,
+	})
+	r := proxy.NewResourceRender(kube.Namespace, infra.GetProxyInfra())
+	key := client.ObjectKey{Namespace: kube.Namespace, Name: r.Name()}
+
+	require.NoError(t, kube.createOrUpdateHorizontalPodAutoscaler(context.Background(), r))
+
+	actual := &autoscalingv2.HorizontalPodAutoscaler{}
+	require.NoError(t, kube.Client.Get(context.Background(), key, actual))
+	require.Equal(t, int32(5), actual.Spec.MaxReplicas)
+	require.Equal(t, "Deployment", actual.Spec.ScaleTargetRef.Kind)
+
+	// Update the scaling bounds.
+	infra = hpaProxyInfra(&egcfgv1a1.KubernetesHorizontalPodAutoscalerSpec{
+		MinReplicas: pointer.Int32(2),
+		MaxReplicas: 10,
+	})
+	r = proxy.NewResourceRender(kube.Namespace, infra.GetProxyInfra())
+	require.NoError(t, kube.createOrUpdateHorizontalPodAutoscaler(context.Background(), r))
+
+	require.NoError(t, kube.Client.Get(context.Background(), key, actual))
+	require.Equal(t, int32(2), *actual.Spec.MinReplicas)
+	require.Equal(t, int32(10), actual.Spec.MaxReplicas)
+
+	// Dropping the HPA stanza removes the HorizontalPodAutoscaler.
+	infra = hpaProxyInfra(nil)
+	r = proxy.NewResourceRender(kube.Namespace, infra.GetProxyInfra())
+	require.NoError(t, kube.createOrUpdateHorizontalPodAutoscaler(context.Background(), r))
+
+	require.Error(t, kube.Client.Get(context.Background(), key, &autoscalingv2.HorizontalPodAutoscaler{}))
+}
+
+// TestCreateOrUpdatePodSetDeletesHorizontalPodAutoscalerOnDaemonSetSwitch
+// covers the scenario where a Deployment-backed proxy with a
+// HorizontalPodAutoscaler switches to a DaemonSet, which doesn't support
+// autoscaling: the stale HorizontalPodAutoscaler must be garbage-collected
+// rather than left behind.
+func TestCreateOrUpdatePodSetDeletesHorizontalPodAutoscalerOnDaemonSetSwitch(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	cli := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).Build()
+	kube := NewInfra(cli, cfg)
+
+	infra := hpaProxyInfra(&egcfgv1a1.KubernetesHorizontalPodAutoscalerSpec{
+		MinReplicas: pointer.Int32(1),
+		MaxReplicas: 5,
+	})
+	r := proxy.NewResourceRender(kube.Namespace, infra.GetProxyInfra())
+	key := client.ObjectKey{Namespace: kube.Namespace, Name: r.Name()}
+
+	require.NoError(t, kube.createOrUpdatePodSet(context.Background(), r))
+	require.NoError(t, kube.Client.Get(context.Background(), key, &autoscalingv2.HorizontalPodAutoscaler{}))
+
+	infra.Proxy.GetProxyConfig().Spec.Provider = &egcfgv1a1.EnvoyProxyProvider{
+		Type: egcfgv1a1.ProviderTypeKubernetes,
+		Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+			EnvoyDaemonSet: &egcfgv1a1.KubernetesDaemonSetSpec{},
+		},
+	}
+	r = proxy.NewResourceRender(kube.Namespace, infra.GetProxyInfra())
+	require.NoError(t, kube.createOrUpdatePodSet(context.Background(), r))
+
+	require.Error(t, kube.Client.Get(context.Background(), key, &autoscalingv2.HorizontalPodAutoscaler{}))
+}
@@ -0,0 +1,263 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+// Package kubernetes implements the infrastructure.Provider for the
+// Kubernetes resource provider, translating the intermediate representation
+// of the Envoy Gateway managed infrastructure into Kubernetes API objects.
+package kubernetes
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/infrastructure"
+	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/proxy"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// Infra manages the creation and deletion of Kubernetes infrastructure
+// resources for Envoy Gateway, such as the Envoy proxy Deployment/DaemonSet
+// and its supporting resources.
+type Infra struct {
+	// Client is the Kubernetes API client used to manage infra resources.
+	Client client.Client
+	// Namespace is the Namespace used for managed infra resources.
+	Namespace string
+	// Mapper is used to detect whether optional CRDs, such as the Prometheus
+	// Operator's ServiceMonitor/PodMonitor, are installed in the cluster. A
+	// nil Mapper is treated as those CRDs not being installed.
+	Mapper meta.RESTMapper
+}
+
+var _ infrastructure.Provider = (*Infra)(nil)
+
+// NewInfra returns a new Infra.
+func NewInfra(cli client.Client, cfg *config.Config) *Infra {
+	return &Infra{
+		Client:    cli,
+		Namespace: cfg.Namespace,
+	}
+}
+
+// CreateOrUpdateProxy creates or updates the Kubernetes infrastructure
+// resources for the managed Envoy proxy fleet described by infra, satisfying
+// the infrastructure.Provider interface.
+func (i *Infra) CreateOrUpdateProxy(ctx context.Context, infra *ir.Infra) error {
+	r := proxy.NewResourceRender(i.Namespace, infra.GetProxyInfra())
+	return i.createOrUpdatePodSet(ctx, r)
+}
+
+// DeleteProxy deletes the Kubernetes infrastructure resources for the
+// managed Envoy proxy fleet described by infra, satisfying the
+// infrastructure.Provider interface.
+func (i *Infra) DeleteProxy(ctx context.Context, infra *ir.Infra) error {
+	r := proxy.NewResourceRender(i.Namespace, infra.GetProxyInfra())
+
+	if err := i.deletePodDisruptionBudget(ctx, r); err != nil {
+		return err
+	}
+	if err := i.deleteHorizontalPodAutoscaler(ctx, r); err != nil {
+		return err
+	}
+	if i.prometheusOperatorCRDInstalled(serviceMonitorGroupKind) {
+		if err := i.deleteServiceMonitor(ctx, r); err != nil {
+			return err
+		}
+	}
+	if i.prometheusOperatorCRDInstalled(podMonitorGroupKind) {
+		if err := i.deletePodMonitor(ctx, r); err != nil {
+			return err
+		}
+	}
+	if err := i.deleteDeployment(ctx, r); err != nil {
+		return err
+	}
+	if err := i.deleteCanaryDeployment(ctx, r); err != nil {
+		return err
+	}
+	if err := i.deleteDaemonSet(ctx, r); err != nil {
+		return err
+	}
+	if err := i.deleteStatefulSet(ctx, r); err != nil {
+		return err
+	}
+	return i.deleteHeadlessService(ctx, r)
+}
+
+// createOrUpdatePodSet creates or updates the Deployment, DaemonSet or
+// StatefulSet described by r, deleting the other two kinds if the proxy's
+// workload type has changed so switching types garbage-collects what came
+// before it.
+func (i *Infra) createOrUpdatePodSet(ctx context.Context, r *proxy.ResourceRender) error {
+	switch {
+	case r.IsDaemonSet():
+		if err := i.deleteDeployment(ctx, r); err != nil {
+			return err
+		}
+		if err := i.deleteCanaryDeployment(ctx, r); err != nil {
+			return err
+		}
+		if err := i.deleteStatefulSet(ctx, r); err != nil {
+			return err
+		}
+		if err := i.deleteHeadlessService(ctx, r); err != nil {
+			return err
+		}
+		if err := i.createOrUpdateDaemonSet(ctx, r); err != nil {
+			return err
+		}
+	case r.IsStatefulSet():
+		if err := i.deleteDeployment(ctx, r); err != nil {
+			return err
+		}
+		if err := i.deleteCanaryDeployment(ctx, r); err != nil {
+			return err
+		}
+		if err := i.deleteDaemonSet(ctx, r); err != nil {
+			return err
+		}
+		if err := i.createOrUpdateHeadlessService(ctx, r); err != nil {
+			return err
+		}
+		if err := i.createOrUpdateStatefulSet(ctx, r); err != nil {
+			return err
+		}
+	default:
+		if err := i.deleteDaemonSet(ctx, r); err != nil {
+			return err
+		}
+		if err := i.deleteStatefulSet(ctx, r); err != nil {
+			return err
+		}
+		if err := i.deleteHeadlessService(ctx, r); err != nil {
+			return err
+		}
+		if err := i.createOrUpdateDeployment(ctx, r); err != nil {
+			return err
+		}
+	}
+
+	if err := i.createOrUpdatePodDisruptionBudget(ctx, r); err != nil {
+		return err
+	}
+	if err := i.createOrUpdateHorizontalPodAutoscaler(ctx, r); err != nil {
+		return err
+	}
+	if err := i.createOrUpdateServiceMonitor(ctx, r); err != nil {
+		return err
+	}
+	return i.createOrUpdatePodMonitor(ctx, r)
+}
+
+// createOrUpdateDeployment creates or updates the Deployment rendered by r.
+// When r is configured with a Canary upgrade strategy, image changes are
+// rolled out via the canary reconciliation path instead of being applied to
+// the primary Deployment directly.
+func (i *Infra) createOrUpdateDeployment(ctx context.Context, r *proxy.ResourceRender) error {
+	deploy, err := r.Deployment()
+	if err != nil {
+		return err
+	}
+
+	current := &appsv1.Deployment{}
+	key := client.ObjectKeyFromObject(deploy)
+	if err := i.Client.Get(ctx, key, current); err != nil {
+		if kerrors.IsNotFound(err) {
+			return i.Client.Create(ctx, deploy)
+		}
+		return err
+	}
+
+	if strategy := r.CanaryUpgradeStrategy(); strategy != nil {
+		return i.reconcileCanaryDeployment(ctx, r, current, deploy, strategy)
+	}
+
+	current.Spec = deploy.Spec
+	return i.Client.Update(ctx, current)
+}
+
+// createOrUpdateDaemonSet creates or updates the DaemonSet rendered by r.
+func (i *Infra) createOrUpdateDaemonSet(ctx context.Context, r *proxy.ResourceRender) error {
+	ds, err := r.DaemonSet()
+	if err != nil {
+		return err
+	}
+
+	current := &appsv1.DaemonSet{}
+	key := client.ObjectKeyFromObject(ds)
+	if err := i.Client.Get(ctx, key, current); err != nil {
+		if kerrors.IsNotFound(err) {
+			return i.Client.Create(ctx, ds)
+		}
+		return err
+	}
+
+	current.Spec = ds.Spec
+	return i.Client.Update(ctx, current)
+}
+
+// createOrUpdateStatefulSet creates or updates the StatefulSet rendered by r.
+func (i *Infra) createOrUpdateStatefulSet(ctx context.Context, r *proxy.ResourceRender) error {
+	sts, err := r.StatefulSet()
+	if err != nil {
+		return err
+	}
+
+	current := &appsv1.StatefulSet{}
+	key := client.ObjectKeyFromObject(sts)
+	if err := i.Client.Get(ctx, key, current); err != nil {
+		if kerrors.IsNotFound(err) {
+			return i.Client.Create(ctx, sts)
+		}
+		return err
+	}
+
+	current.Spec = sts.Spec
+	return i.Client.Update(ctx, current)
+}
+
+// deleteDeployment deletes the Deployment rendered by r, if it exists.
+func (i *Infra) deleteDeployment(ctx context.Context, r *proxy.ResourceRender) error {
+	deploy, err := r.Deployment()
+	if err != nil {
+		return err
+	}
+
+	if err := i.Client.Delete(ctx, deploy); err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// deleteDaemonSet deletes the DaemonSet rendered by r, if it exists.
+func (i *Infra) deleteDaemonSet(ctx context.Context, r *proxy.ResourceRender) error {
+	ds, err := r.DaemonSet()
+	if err != nil {
+		return err
+	}
+
+	if err := i.Client.Delete(ctx, ds); err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// deleteStatefulSet deletes the StatefulSet rendered by r, if it exists.
+func (i *Infra) deleteStatefulSet(ctx context.Context, r *proxy.ResourceRender) error {
+	sts, err := r.StatefulSet()
+	if err != nil {
+		return err
+	}
+
+	if err := i.Client.Delete(ctx, sts); err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package kubernetes
+
+import (
+	"context"
+
+	policyv1 "k8s.io/api/policy/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/proxy"
+)
+
+// createOrUpdatePodDisruptionBudget creates or updates the
+// PodDisruptionBudget rendered by r, deleting it if r is no longer
+// configured with one.
+func (i *Infra) createOrUpdatePodDisruptionBudget(ctx context.Context, r *proxy.ResourceRender) error {
+	pdb, err := r.PodDisruptionBudget()
+	if err != nil {
+		return err
+	}
+	if pdb == nil {
+		return i.deletePodDisruptionBudget(ctx, r)
+	}
+
+	current := &policyv1.PodDisruptionBudget{}
+	key := client.ObjectKeyFromObject(pdb)
+	if err := i.Client.Get(ctx, key, current); err != nil {
+		if kerrors.IsNotFound(err) {
+			return i.Client.Create(ctx, pdb)
+		}
+		return err
+	}
+
+	current.Spec = pdb.Spec
+	return i.Client.Update(ctx, current)
+}
+
+// deletePodDisruptionBudget deletes the PodDisruptionBudget rendered by r, if
+// it exists.
+func (i *Infra) deletePodDisruptionBudget(ctx context.Context, r *proxy.ResourceRender) error {
+	pdb := &policyv1.PodDisruptionBudget{}
+	pdb.Namespace = r.Namespace
+	pdb.Name = r.Name()
+
+	if err := i.Client.Delete(ctx, pdb); err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
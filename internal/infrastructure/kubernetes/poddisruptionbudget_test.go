@@ -0,0 +1,113 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/envoygateway"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/proxy"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// pdbProxyInfra returns an ir.Infra whose Deployment is configured with a
+// PodDisruptionBudget requiring minAvailable, or none if pdb is nil.
+func pdbProxyInfra(pdb *egcfgv1a1.KubernetesPodDisruptionBudgetSpec) *ir.Infra {
+	infra := ir.NewInfra()
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNamespaceLabel] = "default"
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNameLabel] = infra.Proxy.Name
+	infra.Proxy.GetProxyConfig().Spec.Provider = &egcfgv1a1.EnvoyProxyProvider{
+		Type: egcfgv1a1.ProviderTypeKubernetes,
+		Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+			EnvoyDeployment: &egcfgv1a1.KubernetesDeploymentSpec{
+				PodDisruptionBudget: pdb,
+			},
+		},
+	}
+	return infra
+}
+
+func TestCreateOrUpdatePodDisruptionBudget(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	cli := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).Build()
+	kube := NewInfra(cli, cfg)
+
+	minAvailable := intstr.FromInt(1)
+	infra := pdbProxyInfra(&egcfgv1a1.KubernetesPodDisruptionBudgetSpec{
+		MinAvailable: &minAvailable,
+	})
+	r := proxy.NewResourceRender(kube.Namespace, infra.GetProxyInfra())
+	key := client.ObjectKey{Namespace: kube.Namespace, Name: r.Name()}
+
+	require.NoError(t, kube.createOrUpdatePodDisruptionBudget(context.Background(), r))
+
+	actual := &policyv1.PodDisruptionBudget{}
+	require.NoError(t, kube.Client.Get(context.Background(), key, actual))
+	require.Equal(t, minAvailable, *actual.Spec.MinAvailable)
+
+	// Update minAvailable.
+	updated := intstr.FromString("50%")
+	infra = pdbProxyInfra(&egcfgv1a1.KubernetesPodDisruptionBudgetSpec{
+		MinAvailable: &updated,
+	})
+	r = proxy.NewResourceRender(kube.Namespace, infra.GetProxyInfra())
+	require.NoError(t, kube.createOrUpdatePodDisruptionBudget(context.Background(), r))
+
+	require.NoError(t, kube.Client.Get(context.Background(), key, actual))
+	require.Equal(t, updated, *actual.Spec.MinAvailable)
+
+	// Dropping the PDB stanza removes the PodDisruptionBudget.
+	infra = pdbProxyInfra(nil)
+	r = proxy.NewResourceRender(kube.Namespace, infra.GetProxyInfra())
+	require.NoError(t, kube.createOrUpdatePodDisruptionBudget(context.Background(), r))
+
+	require.Error(t, kube.Client.Get(context.Background(), key, &policyv1.PodDisruptionBudget{}))
+}
+
+// TestCreateOrUpdatePodSetDeletesPodDisruptionBudgetOnDaemonSetSwitch covers
+// the scenario where a Deployment-backed proxy with a PodDisruptionBudget
+// switches to a DaemonSet, which doesn't support one: the stale
+// PodDisruptionBudget must be garbage-collected rather than left behind.
+func TestCreateOrUpdatePodSetDeletesPodDisruptionBudgetOnDaemonSetSwitch(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	cli := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).Build()
+	kube := NewInfra(cli, cfg)
+
+	minAvailable := intstr.FromInt(1)
+	infra := pdbProxyInfra(&egcfgv1a1.KubernetesPodDisruptionBudgetSpec{
+		MinAvailable: &minAvailable,
+	})
+	r := proxy.NewResourceRender(kube.Namespace, infra.GetProxyInfra())
+	key := client.ObjectKey{Namespace: kube.Namespace, Name: r.Name()}
+
+	require.NoError(t, kube.createOrUpdatePodSet(context.Background(), r))
+	require.NoError(t, kube.Client.Get(context.Background(), key, &policyv1.PodDisruptionBudget{}))
+
+	infra.Proxy.GetProxyConfig().Spec.Provider = &egcfgv1a1.EnvoyProxyProvider{
+		Type: egcfgv1a1.ProviderTypeKubernetes,
+		Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+			EnvoyDaemonSet: &egcfgv1a1.KubernetesDaemonSetSpec{},
+		},
+	}
+	r = proxy.NewResourceRender(kube.Namespace, infra.GetProxyInfra())
+	require.NoError(t, kube.createOrUpdatePodSet(context.Background(), r))
+
+	require.Error(t, kube.Client.Get(context.Background(), key, &policyv1.PodDisruptionBudget{}))
+}
@@ -0,0 +1,129 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package proxy
+
+import (
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+)
+
+const (
+	// canaryNameSuffix is appended to the primary Deployment's name to derive
+	// the name of its canary Deployment.
+	canaryNameSuffix = "-canary"
+	// defaultCanaryPercentage is used when a Canary upgrade strategy doesn't
+	// specify a percentage.
+	defaultCanaryPercentage int32 = 20
+	// defaultCanaryBakeTime is used when a Canary upgrade strategy doesn't
+	// specify a bake time.
+	defaultCanaryBakeTime = 5 * time.Minute
+	// canaryRoleLabel distinguishes the canary Deployment's pods from the
+	// primary Deployment's pods, so they don't collide on the same selector.
+	canaryRoleLabel = "gateway.envoyproxy.io/canary"
+)
+
+// CanaryUpgradeStrategy returns the Canary upgrade strategy configured for
+// the Deployment, or nil if the proxy isn't configured to use one.
+func (r *ResourceRender) CanaryUpgradeStrategy() *egcfgv1a1.CanaryUpgradeStrategy {
+	deploymentSpec := r.deploymentSpec()
+	if deploymentSpec == nil || deploymentSpec.UpgradeStrategy == nil {
+		return nil
+	}
+	if deploymentSpec.UpgradeStrategy.Type != egcfgv1a1.UpgradeStrategyTypeCanary {
+		return nil
+	}
+	if deploymentSpec.UpgradeStrategy.Canary != nil {
+		return deploymentSpec.UpgradeStrategy.Canary
+	}
+	return &egcfgv1a1.CanaryUpgradeStrategy{}
+}
+
+// CanaryPercentage returns the percentage of replicas, rounded up, that the
+// canary Deployment should be scaled to.
+func CanaryPercentage(strategy *egcfgv1a1.CanaryUpgradeStrategy) int32 {
+	if strategy != nil && strategy.Percentage != nil {
+		return *strategy.Percentage
+	}
+	return defaultCanaryPercentage
+}
+
+// CanaryBakeTime returns the minimum duration the canary Deployment must be
+// ready before it is promoted to the primary Deployment.
+func CanaryBakeTime(strategy *egcfgv1a1.CanaryUpgradeStrategy) time.Duration {
+	if strategy != nil && strategy.BakeTime != nil {
+		return strategy.BakeTime.Duration
+	}
+	return defaultCanaryBakeTime
+}
+
+// CanaryName returns the name used for the canary Deployment derived from
+// the primary Deployment rendered by r.
+func (r *ResourceRender) CanaryName() string {
+	return r.Name() + canaryNameSuffix
+}
+
+// CanaryDeployment returns the canary Deployment derived from the primary
+// Deployment, running targetImage and scaled to percentage of the primary's
+// replica count (minimum of one replica).
+func (r *ResourceRender) CanaryDeployment(primary *appsv1.Deployment, targetImage string, percentage int32) *appsv1.Deployment {
+	canary := primary.DeepCopy()
+	canary.Name = r.CanaryName()
+	canary.ResourceVersion = ""
+
+	canary.Labels = withCanaryLabel(canary.Labels)
+	canary.Spec.Selector.MatchLabels = withCanaryLabel(canary.Spec.Selector.MatchLabels)
+	canary.Spec.Template.Labels = withCanaryLabel(canary.Spec.Template.Labels)
+
+	replicas := canaryReplicaCount(primary.Spec.Replicas, percentage)
+	canary.Spec.Replicas = &replicas
+
+	for i, c := range canary.Spec.Template.Spec.Containers {
+		if c.Name == envoyContainerName {
+			canary.Spec.Template.Spec.Containers[i].Image = targetImage
+		}
+	}
+
+	return canary
+}
+
+// canaryReplicaCount computes the number of canary replicas to run for the
+// given primary replica count and target percentage, rounding up and
+// guaranteeing at least one replica.
+func canaryReplicaCount(primaryReplicas *int32, percentage int32) int32 {
+	total := int32(1)
+	if primaryReplicas != nil {
+		total = *primaryReplicas
+	}
+
+	count := (total*percentage + 99) / 100
+	if count < 1 {
+		count = 1
+	}
+	return count
+}
+
+func withCanaryLabel(labels map[string]string) map[string]string {
+	out := map[string]string{}
+	for k, v := range labels {
+		out[k] = v
+	}
+	out[canaryRoleLabel] = "true"
+	return out
+}
+
+// ContainerImage returns the image of the named Envoy proxy container in
+// deploy, or the empty string if it isn't found.
+func ContainerImage(deploy *appsv1.Deployment) string {
+	for _, c := range deploy.Spec.Template.Spec.Containers {
+		if c.Name == envoyContainerName {
+			return c.Image
+		}
+	}
+	return ""
+}
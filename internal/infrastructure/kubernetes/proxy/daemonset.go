@@ -0,0 +1,45 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package proxy
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+)
+
+// DaemonSet returns the expected DaemonSet resource for the managed Envoy
+// proxy fleet. It is rendered whatever workload type the proxy is configured
+// with, so callers can also use it to identify a DaemonSet to delete.
+func (r *ResourceRender) DaemonSet() (*appsv1.DaemonSet, error) {
+	var daemonSetSpec *egcfgv1a1.KubernetesDaemonSetSpec
+	provider := r.infra.GetProxyConfig().Spec.Provider
+	if provider != nil && provider.Kubernetes != nil {
+		daemonSetSpec = provider.Kubernetes.EnvoyDaemonSet
+	}
+
+	var pod *egcfgv1a1.KubernetesPodSpec
+	var container *egcfgv1a1.KubernetesContainerSpec
+	if daemonSetSpec != nil {
+		pod = daemonSetSpec.Pod
+		container = daemonSetSpec.Container
+	}
+
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: r.Namespace,
+			Name:      r.Name(),
+			Labels:    r.labels(),
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: r.labels(),
+			},
+			Template: r.expectedPodTemplateSpec(pod, container),
+		},
+	}, nil
+}
@@ -0,0 +1,54 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package proxy
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+)
+
+// deploymentSpec returns the user-provided KubernetesDeploymentSpec, or nil
+// if the proxy is not configured with one.
+func (r *ResourceRender) deploymentSpec() *egcfgv1a1.KubernetesDeploymentSpec {
+	provider := r.infra.GetProxyConfig().Spec.Provider
+	if provider != nil && provider.Kubernetes != nil {
+		return provider.Kubernetes.EnvoyDeployment
+	}
+	return nil
+}
+
+// Deployment returns the expected Deployment resource for the managed Envoy
+// proxy fleet. It is rendered whatever workload type the proxy is configured
+// with, so callers can also use it to identify a Deployment to delete.
+func (r *ResourceRender) Deployment() (*appsv1.Deployment, error) {
+	deploymentSpec := r.deploymentSpec()
+
+	var pod *egcfgv1a1.KubernetesPodSpec
+	var container *egcfgv1a1.KubernetesContainerSpec
+	var replicas *int32
+	if deploymentSpec != nil {
+		pod = deploymentSpec.Pod
+		container = deploymentSpec.Container
+		replicas = deploymentSpec.Replicas
+	}
+
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: r.Namespace,
+			Name:      r.Name(),
+			Labels:    r.labels(),
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: replicas,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: r.labels(),
+			},
+			Template: r.expectedPodTemplateSpec(pod, container),
+		},
+	}, nil
+}
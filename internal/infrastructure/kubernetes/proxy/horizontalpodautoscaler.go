@@ -0,0 +1,85 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package proxy
+
+import (
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+)
+
+const (
+	// defaultHPACPUUtilization is the target CPU utilization percentage used
+	// when a HorizontalPodAutoscaler doesn't specify its own Metrics.
+	defaultHPACPUUtilization int32 = 80
+)
+
+// horizontalPodAutoscalerSpec returns the user-provided
+// KubernetesHorizontalPodAutoscalerSpec and the Kind of the workload it
+// scales, or nil if the proxy isn't configured with one. Autoscaling isn't
+// meaningful for a DaemonSet, whose pod count tracks the number of nodes
+// rather than a desired replica count, so only the Deployment and
+// StatefulSet specs are consulted.
+func (r *ResourceRender) horizontalPodAutoscalerSpec() (*egcfgv1a1.KubernetesHorizontalPodAutoscalerSpec, string) {
+	if deploymentSpec := r.deploymentSpec(); deploymentSpec != nil && deploymentSpec.HorizontalPodAutoscaler != nil {
+		return deploymentSpec.HorizontalPodAutoscaler, "Deployment"
+	}
+	if stsSpec := r.statefulSetSpec(); stsSpec != nil && stsSpec.HorizontalPodAutoscaler != nil {
+		return stsSpec.HorizontalPodAutoscaler, "StatefulSet"
+	}
+	return nil, ""
+}
+
+// HorizontalPodAutoscaler returns the expected HorizontalPodAutoscaler
+// resource for the managed Envoy proxy fleet, or nil if the proxy isn't
+// configured with one.
+func (r *ResourceRender) HorizontalPodAutoscaler() (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	hpaSpec, targetKind := r.horizontalPodAutoscalerSpec()
+	if hpaSpec == nil {
+		return nil, nil
+	}
+
+	metrics := hpaSpec.Metrics
+	if len(metrics) == 0 {
+		metrics = []autoscalingv2.MetricSpec{defaultHPAMetric()}
+	}
+
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: r.Namespace,
+			Name:      r.Name(),
+			Labels:    r.labels(),
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       targetKind,
+				Name:       r.Name(),
+			},
+			MinReplicas: hpaSpec.MinReplicas,
+			MaxReplicas: hpaSpec.MaxReplicas,
+			Metrics:     metrics,
+		},
+	}, nil
+}
+
+// defaultHPAMetric returns the default autoscaling policy applied when a
+// HorizontalPodAutoscaler doesn't specify its own Metrics: target CPU
+// utilization of defaultHPACPUUtilization percent.
+func defaultHPAMetric() autoscalingv2.MetricSpec {
+	utilization := defaultHPACPUUtilization
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: "cpu",
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: &utilization,
+			},
+		},
+	}
+}
@@ -0,0 +1,20 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package proxy
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// metaObjectMeta returns an ObjectMeta carrying the given labels and
+// annotations, omitting the Namespace and Name fields which are set by the
+// caller.
+func metaObjectMeta(labels, annotations map[string]string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Labels:      labels,
+		Annotations: annotations,
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package proxy
+
+import (
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+)
+
+// podDisruptionBudgetSpec returns the user-provided
+// KubernetesPodDisruptionBudgetSpec, or nil if the proxy is not configured
+// with one. A PodDisruptionBudget isn't meaningful for a DaemonSet, whose pod
+// count tracks the number of nodes rather than a desired replica count, so
+// only the Deployment and StatefulSet specs are consulted.
+func (r *ResourceRender) podDisruptionBudgetSpec() *egcfgv1a1.KubernetesPodDisruptionBudgetSpec {
+	if deploymentSpec := r.deploymentSpec(); deploymentSpec != nil {
+		return deploymentSpec.PodDisruptionBudget
+	}
+	if stsSpec := r.statefulSetSpec(); stsSpec != nil {
+		return stsSpec.PodDisruptionBudget
+	}
+	return nil
+}
+
+// PodDisruptionBudget returns the expected PodDisruptionBudget resource for
+// the managed Envoy proxy fleet, or nil if the proxy isn't configured with
+// one.
+func (r *ResourceRender) PodDisruptionBudget() (*policyv1.PodDisruptionBudget, error) {
+	pdbSpec := r.podDisruptionBudgetSpec()
+	if pdbSpec == nil {
+		return nil, nil
+	}
+
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: r.Namespace,
+			Name:      r.Name(),
+			Labels:    r.labels(),
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MinAvailable: pdbSpec.MinAvailable,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: r.labels(),
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,84 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package proxy
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/infrastructure/common"
+)
+
+const (
+	// envoyContainerName is the name of the Envoy proxy container.
+	envoyContainerName = "envoy"
+	// envoyAdminPortName is the name of the container port exposing Envoy's
+	// admin interface, including its Prometheus-formatted stats endpoint.
+	envoyAdminPortName = "envoy-admin"
+	// envoyAdminPort is the container port Envoy's admin interface listens on.
+	envoyAdminPort = 19000
+)
+
+// expectedContainerResources returns the Envoy proxy container resource
+// requirements to use, if any were specified.
+func expectedContainerResources(container *egcfgv1a1.KubernetesContainerSpec) corev1.ResourceRequirements {
+	if container != nil && container.Resources != nil {
+		return *container.Resources
+	}
+	return corev1.ResourceRequirements{}
+}
+
+// expectedPodAnnotations returns the pod annotations to apply to the managed
+// Envoy proxy pods.
+func expectedPodAnnotations(pod *egcfgv1a1.KubernetesPodSpec) map[string]string {
+	if pod == nil {
+		return nil
+	}
+	return pod.Annotations
+}
+
+// expectedPodLabels returns the base proxy labels merged with any additional
+// labels specified on the pod spec.
+func expectedPodLabels(base map[string]string, pod *egcfgv1a1.KubernetesPodSpec) map[string]string {
+	labels := map[string]string{}
+	for k, v := range base {
+		labels[k] = v
+	}
+	if pod != nil {
+		for k, v := range pod.Labels {
+			labels[k] = v
+		}
+	}
+	return labels
+}
+
+// expectedPodTemplateSpec returns the PodTemplateSpec shared by the
+// Deployment, DaemonSet and StatefulSet renders of the Envoy proxy fleet.
+func (r *ResourceRender) expectedPodTemplateSpec(pod *egcfgv1a1.KubernetesPodSpec, container *egcfgv1a1.KubernetesContainerSpec) corev1.PodTemplateSpec {
+	image := common.NewProxySpec(r.infra).Image
+	if container != nil && container.Image != nil {
+		image = *container.Image
+	}
+
+	return corev1.PodTemplateSpec{
+		ObjectMeta: metaObjectMeta(expectedPodLabels(r.labels(), pod), expectedPodAnnotations(pod)),
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:      envoyContainerName,
+					Image:     image,
+					Resources: expectedContainerResources(container),
+					Ports: []corev1.ContainerPort{
+						{
+							Name:          envoyAdminPortName,
+							ContainerPort: envoyAdminPort,
+						},
+					},
+				},
+			},
+		},
+	}
+}
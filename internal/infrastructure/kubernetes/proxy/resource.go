@@ -0,0 +1,84 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package proxy
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/envoyproxy/gateway/internal/infrastructure/common"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+const (
+	// resourceNamePrefix is prepended to all managed Envoy proxy infra
+	// resources.
+	resourceNamePrefix = "envoy"
+	// maxResourceNameLength is the maximum length Kubernetes allows for most
+	// resource names (the DNS-1123 subdomain limit).
+	maxResourceNameLength = 253
+)
+
+// ResourceRender renders Kubernetes infrastructure resources for a managed
+// Envoy proxy fleet.
+type ResourceRender struct {
+	// Namespace is the Namespace used for managed infra resources.
+	Namespace string
+
+	infra *ir.ProxyInfra
+}
+
+// NewResourceRender returns a new ResourceRender for the provided ProxyInfra.
+func NewResourceRender(ns string, infra *ir.ProxyInfra) *ResourceRender {
+	return &ResourceRender{
+		Namespace: ns,
+		infra:     infra,
+	}
+}
+
+// Name returns the name used for the managed proxy infra resources.
+func (r *ResourceRender) Name() string {
+	return ExpectedResourceHashedName(r.infra.Name)
+}
+
+// ExpectedResourceHashedName returns the name to use for all managed proxy
+// infra resources: the prefixed name as is, or the prefixed SHA-256 hash of
+// name when the prefixed name would exceed maxResourceNameLength, so that
+// names stay within that limit regardless of the length of the Gateway name
+// they're derived from.
+func ExpectedResourceHashedName(name string) string {
+	hashedName := fmt.Sprintf("%s-%s", resourceNamePrefix, name)
+	if len(hashedName) <= maxResourceNameLength {
+		return hashedName
+	}
+
+	hash := sha256.Sum256([]byte(name))
+	return fmt.Sprintf("%s-%x", resourceNamePrefix, hash)
+}
+
+// IsDaemonSet returns true if the proxy infra is configured to run as a
+// DaemonSet rather than the default Deployment.
+func (r *ResourceRender) IsDaemonSet() bool {
+	provider := r.infra.GetProxyConfig().Spec.Provider
+	return provider != nil && provider.Kubernetes != nil && provider.Kubernetes.EnvoyDaemonSet != nil
+}
+
+// IsStatefulSet returns true if the proxy infra is configured to run as a
+// StatefulSet rather than the default Deployment.
+func (r *ResourceRender) IsStatefulSet() bool {
+	provider := r.infra.GetProxyConfig().Spec.Provider
+	return provider != nil && provider.Kubernetes != nil && provider.Kubernetes.EnvoyStatefulSet != nil
+}
+
+// labels returns the labels that should be applied to all managed proxy
+// infra resources, and their pod templates.
+func (r *ResourceRender) labels() map[string]string {
+	labels := common.NewProxySpec(r.infra).Labels
+	// Kubernetes resource names are hashed to stay within length limits, so
+	// the instance label uses the hashed name rather than the generic spec's.
+	labels["app.kubernetes.io/instance"] = r.Name()
+	return labels
+}
@@ -0,0 +1,33 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package proxy
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HeadlessService returns the headless Service governing the managed Envoy
+// proxy StatefulSet, giving each of its pods the stable per-ordinal DNS
+// identity the StatefulSet's ServiceName refers to, or nil if the proxy
+// isn't configured to run as a StatefulSet.
+func (r *ResourceRender) HeadlessService() (*corev1.Service, error) {
+	if !r.IsStatefulSet() {
+		return nil, nil
+	}
+
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: r.Namespace,
+			Name:      r.Name(),
+			Labels:    r.labels(),
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Selector:  r.labels(),
+		},
+	}, nil
+}
@@ -0,0 +1,76 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package proxy
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+)
+
+// statefulSetSpec returns the user-provided KubernetesStatefulSetSpec, or nil
+// if the proxy is not configured with one.
+func (r *ResourceRender) statefulSetSpec() *egcfgv1a1.KubernetesStatefulSetSpec {
+	provider := r.infra.GetProxyConfig().Spec.Provider
+	if provider != nil && provider.Kubernetes != nil {
+		return provider.Kubernetes.EnvoyStatefulSet
+	}
+	return nil
+}
+
+// StatefulSet returns the expected StatefulSet resource for the managed
+// Envoy proxy fleet. It is rendered whatever workload type the proxy is
+// configured with, so callers can also use it to identify a StatefulSet to
+// delete.
+func (r *ResourceRender) StatefulSet() (*appsv1.StatefulSet, error) {
+	stsSpec := r.statefulSetSpec()
+
+	var pod *egcfgv1a1.KubernetesPodSpec
+	var container *egcfgv1a1.KubernetesContainerSpec
+	var replicas *int32
+	var volumeClaimTemplates []corev1.PersistentVolumeClaim
+	if stsSpec != nil {
+		pod = stsSpec.Pod
+		container = stsSpec.Container
+		replicas = stsSpec.Replicas
+		volumeClaimTemplates = r.expectedVolumeClaimTemplates(stsSpec.VolumeClaimTemplates)
+	}
+
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: r.Namespace,
+			Name:      r.Name(),
+			Labels:    r.labels(),
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:    replicas,
+			ServiceName: r.Name(),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: r.labels(),
+			},
+			Template:             r.expectedPodTemplateSpec(pod, container),
+			VolumeClaimTemplates: volumeClaimTemplates,
+		},
+	}, nil
+}
+
+// expectedVolumeClaimTemplates returns the PersistentVolumeClaim templates to
+// apply to the StatefulSet, tagging each with the proxy's labels so they can
+// be identified alongside the pods they're bound to.
+func (r *ResourceRender) expectedVolumeClaimTemplates(templates []corev1.PersistentVolumeClaim) []corev1.PersistentVolumeClaim {
+	if len(templates) == 0 {
+		return nil
+	}
+
+	out := make([]corev1.PersistentVolumeClaim, len(templates))
+	for i, tmpl := range templates {
+		out[i] = *tmpl.DeepCopy()
+		out[i].Labels = withExtraLabels(r.labels(), tmpl.Labels)
+	}
+	return out
+}
@@ -0,0 +1,116 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package proxy
+
+import (
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+)
+
+const (
+	// envoyAdminMetricsPath is the path Envoy serves Prometheus-formatted
+	// stats on, on its admin interface.
+	envoyAdminMetricsPath = "/stats/prometheus"
+	// defaultMetricsScrapeInterval is used when a PrometheusOperator metric
+	// sink doesn't specify its own Interval.
+	defaultMetricsScrapeInterval monitoringv1.Duration = "30s"
+)
+
+// prometheusOperatorMetricSink returns the user-provided
+// ProxyPrometheusOperatorMetricSink, or nil if the proxy isn't configured
+// with one.
+func (r *ResourceRender) prometheusOperatorMetricSink() *egcfgv1a1.ProxyPrometheusOperatorMetricSink {
+	telemetry := r.infra.GetProxyConfig().Spec.Telemetry
+	if telemetry == nil || telemetry.Metrics == nil {
+		return nil
+	}
+	return telemetry.Metrics.PrometheusOperator
+}
+
+// ServiceMonitor returns the expected ServiceMonitor resource scraping the
+// managed Envoy proxy fleet's admin metrics endpoint, or nil if the proxy
+// isn't configured with a PrometheusOperator metric sink, or is configured
+// to run as a DaemonSet (which is scraped via a PodMonitor instead).
+func (r *ResourceRender) ServiceMonitor() (*monitoringv1.ServiceMonitor, error) {
+	sink := r.prometheusOperatorMetricSink()
+	if sink == nil || r.IsDaemonSet() {
+		return nil, nil
+	}
+
+	return &monitoringv1.ServiceMonitor{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: r.Namespace,
+			Name:      r.Name(),
+			Labels:    withExtraLabels(r.labels(), sink.Labels),
+		},
+		Spec: monitoringv1.ServiceMonitorSpec{
+			Selector: metav1.LabelSelector{
+				MatchLabels: r.labels(),
+			},
+			Endpoints: []monitoringv1.Endpoint{
+				{
+					Port:     envoyAdminPortName,
+					Path:     envoyAdminMetricsPath,
+					Interval: expectedScrapeInterval(sink.Interval),
+				},
+			},
+		},
+	}, nil
+}
+
+// PodMonitor returns the expected PodMonitor resource scraping the managed
+// Envoy proxy fleet's admin metrics endpoint, or nil if the proxy isn't
+// configured with a PrometheusOperator metric sink, or isn't configured to
+// run as a DaemonSet (which is scraped via a ServiceMonitor instead).
+func (r *ResourceRender) PodMonitor() (*monitoringv1.PodMonitor, error) {
+	sink := r.prometheusOperatorMetricSink()
+	if sink == nil || !r.IsDaemonSet() {
+		return nil, nil
+	}
+
+	return &monitoringv1.PodMonitor{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: r.Namespace,
+			Name:      r.Name(),
+			Labels:    withExtraLabels(r.labels(), sink.Labels),
+		},
+		Spec: monitoringv1.PodMonitorSpec{
+			Selector: metav1.LabelSelector{
+				MatchLabels: r.labels(),
+			},
+			PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{
+				{
+					Port:     envoyAdminPortName,
+					Path:     envoyAdminMetricsPath,
+					Interval: expectedScrapeInterval(sink.Interval),
+				},
+			},
+		},
+	}, nil
+}
+
+// expectedScrapeInterval returns the scrape interval to use, falling back to
+// defaultMetricsScrapeInterval when unspecified.
+func expectedScrapeInterval(interval *metav1.Duration) monitoringv1.Duration {
+	if interval != nil {
+		return monitoringv1.Duration(interval.Duration.String())
+	}
+	return defaultMetricsScrapeInterval
+}
+
+// withExtraLabels returns base merged with extra, without mutating either.
+func withExtraLabels(base, extra map[string]string) map[string]string {
+	out := map[string]string{}
+	for k, v := range base {
+		out[k] = v
+	}
+	for k, v := range extra {
+		out[k] = v
+	}
+	return out
+}
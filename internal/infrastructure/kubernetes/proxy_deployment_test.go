@@ -63,12 +63,52 @@ func TestCreateOrUpdateProxyDeployment(t *testing.T) {
 	require.NoError(t, err)
 	infra.Proxy.GetProxyConfig().Spec.Provider = nil
 
+	// Extract what the StatefulSet would look like, had it been configured.
+	infra.Proxy.GetProxyConfig().Spec.Provider = &egcfgv1a1.EnvoyProxyProvider{
+		Type: egcfgv1a1.ProviderTypeKubernetes,
+		Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+			EnvoyStatefulSet: &egcfgv1a1.KubernetesStatefulSetSpec{},
+		},
+	}
+	r = proxy.NewResourceRender(cfg.Namespace, infra.GetProxyInfra())
+	wantStatefulSet, err := r.StatefulSet()
+	require.NoError(t, err)
+	infra.Proxy.GetProxyConfig().Spec.Provider = nil
+
+	statefulSetProxyInfra := func() *ir.Infra {
+		return &ir.Infra{
+			Proxy: &ir.ProxyInfra{
+				Metadata: &ir.InfraMetadata{
+					Labels: map[string]string{
+						gatewayapi.OwningGatewayNamespaceLabel: "default",
+						gatewayapi.OwningGatewayNameLabel:      infra.Proxy.Name,
+					},
+				},
+				Config: &egcfgv1a1.EnvoyProxy{
+					Spec: egcfgv1a1.EnvoyProxySpec{
+						Provider: &egcfgv1a1.EnvoyProxyProvider{
+							Type: egcfgv1a1.ProviderTypeKubernetes,
+							Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+								EnvoyStatefulSet: &egcfgv1a1.KubernetesStatefulSetSpec{},
+							},
+						},
+					},
+				},
+				Name:      ir.DefaultProxyName,
+				Listeners: ir.NewProxyListeners(),
+			},
+		}
+	}
+
 	testCases := []struct {
-		name          string
-		in            *ir.Infra
-		current       *appsv1.Deployment
-		want          *appsv1.Deployment
-		wantDaemonSet *appsv1.DaemonSet
+		name            string
+		in              *ir.Infra
+		current         *appsv1.Deployment
+		currentDaemon   *appsv1.DaemonSet
+		currentStateful *appsv1.StatefulSet
+		want            *appsv1.Deployment
+		wantDaemonSet   *appsv1.DaemonSet
+		wantStatefulSet *appsv1.StatefulSet
 	}{
 		{
 			name: "create deployment",
@@ -139,17 +179,66 @@ func TestCreateOrUpdateProxyDeployment(t *testing.T) {
 			current:       deploy,
 			wantDaemonSet: wantDaemonSet,
 		},
+		{
+			name:            "create statefulset",
+			in:              statefulSetProxyInfra(),
+			wantStatefulSet: wantStatefulSet,
+		},
+		{
+			name:            "update to statefulset",
+			in:              statefulSetProxyInfra(),
+			current:         deploy,
+			wantStatefulSet: wantStatefulSet,
+		},
+		{
+			name:    "update from statefulset to deployment",
+			in:      infra,
+			currentStateful: wantStatefulSet,
+			want:    deploy,
+		},
+		{
+			name:          "update from statefulset to daemonset",
+			in: &ir.Infra{
+				Proxy: &ir.ProxyInfra{
+					Metadata: &ir.InfraMetadata{
+						Labels: map[string]string{
+							gatewayapi.OwningGatewayNamespaceLabel: "default",
+							gatewayapi.OwningGatewayNameLabel:      infra.Proxy.Name,
+						},
+					},
+					Config: &egcfgv1a1.EnvoyProxy{
+						Spec: egcfgv1a1.EnvoyProxySpec{
+							Provider: &egcfgv1a1.EnvoyProxyProvider{
+								Type: egcfgv1a1.ProviderTypeKubernetes,
+								Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+									EnvoyDaemonSet: &egcfgv1a1.KubernetesDaemonSetSpec{},
+								},
+							},
+						},
+					},
+					Name:      ir.DefaultProxyName,
+					Listeners: ir.NewProxyListeners(),
+				},
+			},
+			currentStateful: wantStatefulSet,
+			wantDaemonSet:   wantDaemonSet,
+		},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			var cli client.Client
+			var currentObjs []client.Object
 			if tc.current != nil {
-				cli = fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).WithObjects(tc.current).Build()
-			} else {
-				cli = fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).Build()
+				currentObjs = append(currentObjs, tc.current)
+			}
+			if tc.currentDaemon != nil {
+				currentObjs = append(currentObjs, tc.currentDaemon)
+			}
+			if tc.currentStateful != nil {
+				currentObjs = append(currentObjs, tc.currentStateful)
 			}
+			cli := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).WithObjects(currentObjs...).Build()
 
 			kube := NewInfra(cli, cfg)
 			r := proxy.NewResourceRender(kube.Namespace, tc.in.GetProxyInfra())
@@ -179,6 +268,25 @@ func TestCreateOrUpdateProxyDeployment(t *testing.T) {
 				require.NoError(t, kube.Client.Get(context.Background(), client.ObjectKeyFromObject(actual), actual))
 				require.Equal(t, tc.wantDaemonSet.Spec, actual.Spec)
 			}
+
+			if tc.wantStatefulSet != nil {
+				actual := &appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: kube.Namespace,
+						Name:      proxy.ExpectedResourceHashedName(tc.in.Proxy.Name),
+					},
+				}
+				require.NoError(t, kube.Client.Get(context.Background(), client.ObjectKeyFromObject(actual), actual))
+				require.Equal(t, tc.wantStatefulSet.Spec, actual.Spec)
+			} else if tc.currentStateful != nil {
+				actual := &appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: kube.Namespace,
+						Name:      proxy.ExpectedResourceHashedName(tc.in.Proxy.Name),
+					},
+				}
+				require.Error(t, kube.Client.Get(context.Background(), client.ObjectKeyFromObject(actual), actual))
+			}
 		})
 	}
 }
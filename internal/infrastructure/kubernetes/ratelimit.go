@@ -0,0 +1,94 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package kubernetes
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// rateLimitContainerName is the name of the global rate limit service
+// container.
+const rateLimitContainerName = "envoy-ratelimit"
+
+// rateLimitLabels returns the labels that should be applied to the managed
+// global rate limit service resources.
+func rateLimitLabels(infra *ir.RateLimitInfra) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":      "envoy-ratelimit",
+		"app.kubernetes.io/component": "rate-limit",
+		"app.kubernetes.io/instance":  infra.Name,
+	}
+}
+
+// expectedRateLimitDeployment returns the expected Deployment resource for
+// the managed global rate limit service described by infra.
+func (i *Infra) expectedRateLimitDeployment(infra *ir.RateLimitInfra) *appsv1.Deployment {
+	labels := rateLimitLabels(infra)
+
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: i.Namespace,
+			Name:      infra.Name,
+			Labels:    labels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  rateLimitContainerName,
+							Image: infra.Image,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// CreateOrUpdateRateLimit creates or updates the Deployment for the managed
+// global rate limit service described by infra, satisfying the
+// infrastructure.Provider interface.
+func (i *Infra) CreateOrUpdateRateLimit(ctx context.Context, infra *ir.RateLimitInfra) error {
+	deploy := i.expectedRateLimitDeployment(infra)
+
+	current := &appsv1.Deployment{}
+	key := client.ObjectKeyFromObject(deploy)
+	if err := i.Client.Get(ctx, key, current); err != nil {
+		if kerrors.IsNotFound(err) {
+			return i.Client.Create(ctx, deploy)
+		}
+		return err
+	}
+
+	current.Spec = deploy.Spec
+	return i.Client.Update(ctx, current)
+}
+
+// DeleteRateLimit deletes the Deployment for the managed global rate limit
+// service described by infra, if it exists, satisfying the
+// infrastructure.Provider interface.
+func (i *Infra) DeleteRateLimit(ctx context.Context, infra *ir.RateLimitInfra) error {
+	deploy := i.expectedRateLimitDeployment(infra)
+	if err := i.Client.Delete(ctx, deploy); err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
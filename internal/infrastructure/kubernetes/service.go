@@ -0,0 +1,55 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package kubernetes
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/proxy"
+)
+
+// createOrUpdateHeadlessService creates or updates the headless Service
+// rendered by r, deleting it if r is no longer configured to run as a
+// StatefulSet. ClusterIP is immutable once set, so only the Service's
+// Selector is kept in sync on update.
+func (i *Infra) createOrUpdateHeadlessService(ctx context.Context, r *proxy.ResourceRender) error {
+	svc, err := r.HeadlessService()
+	if err != nil {
+		return err
+	}
+	if svc == nil {
+		return i.deleteHeadlessService(ctx, r)
+	}
+
+	current := &corev1.Service{}
+	key := client.ObjectKeyFromObject(svc)
+	if err := i.Client.Get(ctx, key, current); err != nil {
+		if kerrors.IsNotFound(err) {
+			return i.Client.Create(ctx, svc)
+		}
+		return err
+	}
+
+	current.Spec.Selector = svc.Spec.Selector
+	return i.Client.Update(ctx, current)
+}
+
+// deleteHeadlessService deletes the headless Service rendered by r, if it
+// exists.
+func (i *Infra) deleteHeadlessService(ctx context.Context, r *proxy.ResourceRender) error {
+	svc := &corev1.Service{}
+	svc.Namespace = r.Namespace
+	svc.Name = r.Name()
+
+	if err := i.Client.Delete(ctx, svc); err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/envoygateway"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/proxy"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// statefulSetProxyInfra returns an ir.Infra configured to run the Envoy
+// proxy fleet as a StatefulSet, or as a Deployment if statefulSet is false.
+func statefulSetProxyInfra(statefulSet bool) *ir.Infra {
+	infra := ir.NewInfra()
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNamespaceLabel] = "default"
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNameLabel] = infra.Proxy.Name
+
+	provider := &egcfgv1a1.EnvoyProxyKubernetesProvider{}
+	if statefulSet {
+		provider.EnvoyStatefulSet = &egcfgv1a1.KubernetesStatefulSetSpec{}
+	} else {
+		provider.EnvoyDeployment = &egcfgv1a1.KubernetesDeploymentSpec{}
+	}
+	infra.Proxy.GetProxyConfig().Spec.Provider = &egcfgv1a1.EnvoyProxyProvider{
+		Type:       egcfgv1a1.ProviderTypeKubernetes,
+		Kubernetes: provider,
+	}
+	return infra
+}
+
+func TestCreateOrUpdatePodSetHeadlessService(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	cli := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).Build()
+	kube := NewInfra(cli, cfg)
+
+	infra := statefulSetProxyInfra(true)
+	r := proxy.NewResourceRender(kube.Namespace, infra.GetProxyInfra())
+	key := client.ObjectKey{Namespace: kube.Namespace, Name: r.Name()}
+
+	require.NoError(t, kube.createOrUpdatePodSet(context.Background(), r))
+
+	actual := &corev1.Service{}
+	require.NoError(t, kube.Client.Get(context.Background(), key, actual))
+	require.Equal(t, corev1.ClusterIPNone, actual.Spec.ClusterIP)
+	require.NotEmpty(t, actual.Spec.Selector)
+	require.Equal(t, actual.Spec.Selector, actual.Labels)
+
+	// Switching back to a Deployment removes the headless Service.
+	infra = statefulSetProxyInfra(false)
+	r = proxy.NewResourceRender(kube.Namespace, infra.GetProxyInfra())
+	require.NoError(t, kube.createOrUpdatePodSet(context.Background(), r))
+
+	require.Error(t, kube.Client.Get(context.Background(), key, &corev1.Service{}))
+}
@@ -0,0 +1,125 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package kubernetes
+
+import (
+	"context"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/proxy"
+)
+
+var (
+	// serviceMonitorGroupKind identifies the Prometheus Operator's
+	// ServiceMonitor CRD, for RESTMapper-based detection of whether it's
+	// installed in the cluster.
+	serviceMonitorGroupKind = schema.GroupKind{Group: monitoringv1.SchemeGroupVersion.Group, Kind: monitoringv1.ServiceMonitorsKind}
+	// podMonitorGroupKind identifies the Prometheus Operator's PodMonitor CRD,
+	// for RESTMapper-based detection of whether it's installed in the
+	// cluster.
+	podMonitorGroupKind = schema.GroupKind{Group: monitoringv1.SchemeGroupVersion.Group, Kind: monitoringv1.PodMonitorsKind}
+)
+
+// prometheusOperatorCRDInstalled returns true if gk is registered with the
+// API server, as reported by i.Mapper. A nil Mapper is treated as the CRD
+// not being installed, so an Infra that doesn't wire one up skips
+// Prometheus Operator reconciliation entirely rather than failing.
+func (i *Infra) prometheusOperatorCRDInstalled(gk schema.GroupKind) bool {
+	if i.Mapper == nil {
+		return false
+	}
+	_, err := i.Mapper.RESTMapping(gk)
+	return err == nil
+}
+
+// createOrUpdateServiceMonitor creates or updates the ServiceMonitor
+// rendered by r, deleting it if r is no longer configured with one. Skipped
+// entirely if the Prometheus Operator's ServiceMonitor CRD isn't installed.
+func (i *Infra) createOrUpdateServiceMonitor(ctx context.Context, r *proxy.ResourceRender) error {
+	if !i.prometheusOperatorCRDInstalled(serviceMonitorGroupKind) {
+		return nil
+	}
+
+	sm, err := r.ServiceMonitor()
+	if err != nil {
+		return err
+	}
+	if sm == nil {
+		return i.deleteServiceMonitor(ctx, r)
+	}
+
+	current := &monitoringv1.ServiceMonitor{}
+	key := client.ObjectKeyFromObject(sm)
+	if err := i.Client.Get(ctx, key, current); err != nil {
+		if kerrors.IsNotFound(err) {
+			return i.Client.Create(ctx, sm)
+		}
+		return err
+	}
+
+	current.Labels = sm.Labels
+	current.Spec = sm.Spec
+	return i.Client.Update(ctx, current)
+}
+
+// deleteServiceMonitor deletes the ServiceMonitor rendered by r, if it
+// exists.
+func (i *Infra) deleteServiceMonitor(ctx context.Context, r *proxy.ResourceRender) error {
+	sm := &monitoringv1.ServiceMonitor{}
+	sm.Namespace = r.Namespace
+	sm.Name = r.Name()
+
+	if err := i.Client.Delete(ctx, sm); err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// createOrUpdatePodMonitor creates or updates the PodMonitor rendered by r,
+// deleting it if r is no longer configured with one. Skipped entirely if the
+// Prometheus Operator's PodMonitor CRD isn't installed.
+func (i *Infra) createOrUpdatePodMonitor(ctx context.Context, r *proxy.ResourceRender) error {
+	if !i.prometheusOperatorCRDInstalled(podMonitorGroupKind) {
+		return nil
+	}
+
+	pm, err := r.PodMonitor()
+	if err != nil {
+		return err
+	}
+	if pm == nil {
+		return i.deletePodMonitor(ctx, r)
+	}
+
+	current := &monitoringv1.PodMonitor{}
+	key := client.ObjectKeyFromObject(pm)
+	if err := i.Client.Get(ctx, key, current); err != nil {
+		if kerrors.IsNotFound(err) {
+			return i.Client.Create(ctx, pm)
+		}
+		return err
+	}
+
+	current.Labels = pm.Labels
+	current.Spec = pm.Spec
+	return i.Client.Update(ctx, current)
+}
+
+// deletePodMonitor deletes the PodMonitor rendered by r, if it exists.
+func (i *Infra) deletePodMonitor(ctx context.Context, r *proxy.ResourceRender) error {
+	pm := &monitoringv1.PodMonitor{}
+	pm.Namespace = r.Namespace
+	pm.Name = r.Name()
+
+	if err := i.Client.Delete(ctx, pm); err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
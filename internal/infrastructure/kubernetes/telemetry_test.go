@@ -0,0 +1,142 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/envoygateway"
+	"github.com/envoyproxy/gateway/internal/envoygateway/config"
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/infrastructure/kubernetes/proxy"
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// prometheusOperatorTestMapper returns a RESTMapper reporting the Prometheus
+// Operator's ServiceMonitor and PodMonitor CRDs as installed.
+func prometheusOperatorTestMapper() meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{monitoringv1.SchemeGroupVersion})
+	mapper.Add(monitoringv1.SchemeGroupVersion.WithKind(monitoringv1.ServiceMonitorsKind), meta.RESTScopeNamespace)
+	mapper.Add(monitoringv1.SchemeGroupVersion.WithKind(monitoringv1.PodMonitorsKind), meta.RESTScopeNamespace)
+	return mapper
+}
+
+func telemetryProxyInfra(provider *egcfgv1a1.EnvoyProxyProvider, sink *egcfgv1a1.ProxyPrometheusOperatorMetricSink) *ir.Infra {
+	infra := ir.NewInfra()
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNamespaceLabel] = "default"
+	infra.Proxy.GetProxyMetadata().Labels[gatewayapi.OwningGatewayNameLabel] = infra.Proxy.Name
+	infra.Proxy.GetProxyConfig().Spec.Provider = provider
+	if sink != nil {
+		infra.Proxy.GetProxyConfig().Spec.Telemetry = &egcfgv1a1.ProxyTelemetry{
+			Metrics: &egcfgv1a1.ProxyMetrics{
+				PrometheusOperator: sink,
+			},
+		}
+	}
+	return infra
+}
+
+func TestCreateOrUpdateServiceMonitor(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	cli := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).Build()
+	kube := NewInfra(cli, cfg)
+	kube.Mapper = prometheusOperatorTestMapper()
+
+	infra := telemetryProxyInfra(nil, &egcfgv1a1.ProxyPrometheusOperatorMetricSink{})
+	r := proxy.NewResourceRender(kube.Namespace, infra.GetProxyInfra())
+	key := client.ObjectKey{Namespace: kube.Namespace, Name: r.Name()}
+
+	require.NoError(t, kube.createOrUpdateServiceMonitor(context.Background(), r))
+
+	actual := &monitoringv1.ServiceMonitor{}
+	require.NoError(t, kube.Client.Get(context.Background(), key, actual))
+	require.Equal(t, monitoringv1.Duration("30s"), actual.Spec.Endpoints[0].Interval)
+
+	// Update the scrape interval and add a label a Prometheus Operator
+	// ServiceMonitorSelector might match on.
+	customInterval := metav1.Duration{Duration: 15 * time.Second}
+	infra = telemetryProxyInfra(nil, &egcfgv1a1.ProxyPrometheusOperatorMetricSink{
+		Interval: &customInterval,
+		Labels:   map[string]string{"release": "prometheus"},
+	})
+	r = proxy.NewResourceRender(kube.Namespace, infra.GetProxyInfra())
+	require.NoError(t, kube.createOrUpdateServiceMonitor(context.Background(), r))
+
+	require.NoError(t, kube.Client.Get(context.Background(), key, actual))
+	require.Equal(t, monitoringv1.Duration("15s"), actual.Spec.Endpoints[0].Interval)
+	require.Equal(t, "prometheus", actual.Labels["release"])
+
+	// Clearing the telemetry stanza removes the ServiceMonitor.
+	infra = telemetryProxyInfra(nil, nil)
+	r = proxy.NewResourceRender(kube.Namespace, infra.GetProxyInfra())
+	require.NoError(t, kube.createOrUpdateServiceMonitor(context.Background(), r))
+
+	require.Error(t, kube.Client.Get(context.Background(), key, &monitoringv1.ServiceMonitor{}))
+}
+
+func TestCreateOrUpdatePodMonitor(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	cli := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).Build()
+	kube := NewInfra(cli, cfg)
+	kube.Mapper = prometheusOperatorTestMapper()
+
+	daemonSetProvider := &egcfgv1a1.EnvoyProxyProvider{
+		Type: egcfgv1a1.ProviderTypeKubernetes,
+		Kubernetes: &egcfgv1a1.EnvoyProxyKubernetesProvider{
+			EnvoyDaemonSet: &egcfgv1a1.KubernetesDaemonSetSpec{},
+		},
+	}
+
+	infra := telemetryProxyInfra(daemonSetProvider, &egcfgv1a1.ProxyPrometheusOperatorMetricSink{})
+	r := proxy.NewResourceRender(kube.Namespace, infra.GetProxyInfra())
+	key := client.ObjectKey{Namespace: kube.Namespace, Name: r.Name()}
+
+	require.NoError(t, kube.createOrUpdatePodMonitor(context.Background(), r))
+
+	actual := &monitoringv1.PodMonitor{}
+	require.NoError(t, kube.Client.Get(context.Background(), key, actual))
+	require.Len(t, actual.Spec.PodMetricsEndpoints, 1)
+
+	// A DaemonSet is monitored with a PodMonitor, never a ServiceMonitor.
+	require.Error(t, kube.Client.Get(context.Background(), key, &monitoringv1.ServiceMonitor{}))
+
+	// Clearing the telemetry stanza removes the PodMonitor.
+	infra = telemetryProxyInfra(daemonSetProvider, nil)
+	r = proxy.NewResourceRender(kube.Namespace, infra.GetProxyInfra())
+	require.NoError(t, kube.createOrUpdatePodMonitor(context.Background(), r))
+
+	require.Error(t, kube.Client.Get(context.Background(), key, &monitoringv1.PodMonitor{}))
+}
+
+func TestPrometheusOperatorCRDsNotInstalled(t *testing.T) {
+	cfg, err := config.New()
+	require.NoError(t, err)
+
+	cli := fakeclient.NewClientBuilder().WithScheme(envoygateway.GetScheme()).Build()
+	kube := NewInfra(cli, cfg)
+
+	infra := telemetryProxyInfra(nil, &egcfgv1a1.ProxyPrometheusOperatorMetricSink{})
+	r := proxy.NewResourceRender(kube.Namespace, infra.GetProxyInfra())
+
+	// With no Mapper wired up, reconciliation is a no-op rather than an error.
+	require.NoError(t, kube.createOrUpdateServiceMonitor(context.Background(), r))
+	require.Error(t, kube.Client.Get(context.Background(), client.ObjectKey{Namespace: kube.Namespace, Name: r.Name()}, &monitoringv1.ServiceMonitor{}))
+}
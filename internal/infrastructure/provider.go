@@ -0,0 +1,34 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+// Package infrastructure defines the provider-agnostic contract for managing
+// Envoy Gateway's infrastructure resources, such as the managed Envoy proxy
+// fleet.
+package infrastructure
+
+import (
+	"context"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// Provider provides the interface for managing Envoy Gateway infrastructure
+// resources. Implementations translate the intermediate representation of
+// the managed infrastructure into the primitives of a specific backend, such
+// as Kubernetes or Docker.
+type Provider interface {
+	// CreateOrUpdateProxy creates or updates the managed Envoy proxy
+	// infrastructure described by infra.
+	CreateOrUpdateProxy(ctx context.Context, infra *ir.Infra) error
+	// DeleteProxy deletes the managed Envoy proxy infrastructure described by
+	// infra.
+	DeleteProxy(ctx context.Context, infra *ir.Infra) error
+	// CreateOrUpdateRateLimit creates or updates the managed global rate
+	// limit service infrastructure described by infra.
+	CreateOrUpdateRateLimit(ctx context.Context, infra *ir.RateLimitInfra) error
+	// DeleteRateLimit deletes the managed global rate limit service
+	// infrastructure described by infra.
+	DeleteRateLimit(ctx context.Context, infra *ir.RateLimitInfra) error
+}
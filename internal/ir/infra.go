@@ -0,0 +1,130 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package ir
+
+import (
+	egcfgv1a1 "github.com/envoyproxy/gateway/api/config/v1alpha1"
+)
+
+const (
+	// DefaultProxyName is the name used for the managed Envoy proxy fleet when
+	// none is specified.
+	DefaultProxyName = "default"
+	// DefaultRateLimitName is the name used for the managed global rate
+	// limit service when none is specified.
+	DefaultRateLimitName = "envoy-ratelimit"
+)
+
+// Infra defines the intermediate representation of the infrastructure
+// resources that need to be managed for a Gateway.
+type Infra struct {
+	// Proxy defines the desired state of the Envoy proxy infrastructure.
+	Proxy *ProxyInfra
+}
+
+// NewInfra returns a new Infra with default parameters.
+func NewInfra() *Infra {
+	return &Infra{
+		Proxy: newProxyInfra(),
+	}
+}
+
+// GetProxyInfra returns the ProxyInfra of the Infra.
+func (i *Infra) GetProxyInfra() *ProxyInfra {
+	if i.Proxy == nil {
+		i.Proxy = newProxyInfra()
+	}
+	return i.Proxy
+}
+
+// ProxyInfra defines the intermediate representation of the Envoy proxy
+// infrastructure resources that need to be managed.
+type ProxyInfra struct {
+	// Metadata defines metadata that should be used to further describe the
+	// proxy infra resources.
+	Metadata *InfraMetadata
+	// Name is the name used for managed proxy infra resources.
+	Name string
+	// Config defines user-facing configuration of the managed proxy fleet.
+	Config *egcfgv1a1.EnvoyProxy
+	// Listeners defines the set of listeners the proxy infra should be
+	// configured to serve.
+	Listeners ProxyListeners
+}
+
+func newProxyInfra() *ProxyInfra {
+	return &ProxyInfra{
+		Metadata:  newInfraMetadata(),
+		Name:      DefaultProxyName,
+		Config:    &egcfgv1a1.EnvoyProxy{},
+		Listeners: NewProxyListeners(),
+	}
+}
+
+// GetProxyMetadata returns the Metadata of the ProxyInfra.
+func (p *ProxyInfra) GetProxyMetadata() *InfraMetadata {
+	if p.Metadata == nil {
+		p.Metadata = newInfraMetadata()
+	}
+	return p.Metadata
+}
+
+// GetProxyConfig returns the Config of the ProxyInfra.
+func (p *ProxyInfra) GetProxyConfig() *egcfgv1a1.EnvoyProxy {
+	if p.Config == nil {
+		p.Config = &egcfgv1a1.EnvoyProxy{}
+	}
+	return p.Config
+}
+
+// InfraMetadata defines metadata that should be used to further describe
+// managed infra resources.
+type InfraMetadata struct {
+	// Labels are the additional labels that should be tagged to the resources.
+	Labels map[string]string
+}
+
+func newInfraMetadata() *InfraMetadata {
+	return &InfraMetadata{
+		Labels: map[string]string{},
+	}
+}
+
+// RateLimitInfra defines the intermediate representation of the global rate
+// limit service infrastructure resources that need to be managed.
+type RateLimitInfra struct {
+	// Name is the name used for managed rate limit infra resources.
+	Name string
+	// Image is the container image used to run the rate limit service.
+	Image string
+}
+
+// NewRateLimitInfra returns a new RateLimitInfra for the rate limit service
+// image provided.
+func NewRateLimitInfra(image string) *RateLimitInfra {
+	return &RateLimitInfra{
+		Name:  DefaultRateLimitName,
+		Image: image,
+	}
+}
+
+// ProxyListener defines the listeners exposed by the proxy infra.
+type ProxyListener struct {
+	// Name of the ProxyListener.
+	Name string
+	// Address that the listener should listen on.
+	Address string
+	// Ports to expose on the proxy infra resources.
+	Ports []int32
+}
+
+// ProxyListeners is a list of ProxyListener.
+type ProxyListeners []*ProxyListener
+
+// NewProxyListeners returns an empty ProxyListeners.
+func NewProxyListeners() ProxyListeners {
+	return ProxyListeners{}
+}